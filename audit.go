@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audited actions
+const (
+	auditActionCreateTags                    = "CreateTags"
+	auditActionDeleteTags                    = "DeleteTags"
+	auditActionTerminateInstances            = "TerminateInstances"
+	auditActionRebootInstances               = "RebootInstances"
+	auditActionSendCommand                   = "SendCommand"
+	auditActionUpdateContainerAgent          = "UpdateContainerAgent"
+	auditActionDeregisterContainerInstance   = "DeregisterContainerInstance"
+	auditActionUpdateContainerInstancesState = "UpdateContainerInstancesState"
+
+	auditActionDeregisterInstancesFromLoadBalancer = "DeregisterInstancesFromLoadBalancer"
+	auditActionDetachInstances                     = "DetachInstances"
+	auditActionModifyInstanceAttribute             = "ModifyInstanceAttribute"
+	auditActionStopInstances                       = "StopInstances"
+	auditActionTerminateInstanceInAutoScalingGroup = "TerminateInstanceInAutoScalingGroup"
+)
+
+const auditStdout = "-"
+
+// agentStatus is an observation of the agent connection state of an instance
+type agentStatus struct {
+	At        time.Time `json:"at"`
+	Connected bool      `json:"connected"`
+}
+
+// AuditEvidence is the evidence behind an automated action
+type AuditEvidence struct {
+	FirstUnhealthy        *time.Time        `json:"first_unhealthy,omitempty"`
+	AgentConnectedHistory []agentStatus     `json:"agent_connected_history,omitempty"`
+	Verdicts              []*Verdict        `json:"verdicts,omitempty"`
+	Batch                 int               `json:"batch,omitempty"`
+	Thresholds            map[string]string `json:"thresholds,omitempty"`
+}
+
+// AuditRecord is an entry of the audit log, one per action and instance
+type AuditRecord struct {
+	Time       time.Time         `json:"time"`
+	Cluster    string            `json:"cluster"`
+	Component  string            `json:"component"`
+	Action     string            `json:"action"`
+	InstanceID string            `json:"instance_id"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Evidence   AuditEvidence     `json:"evidence"`
+}
+
+// Auditor records the automated actions taken on the cluster
+type Auditor interface {
+	Audit(records ...*AuditRecord) error
+}
+
+// JSONAuditor writes the audit records as JSON lines, it only appends
+type JSONAuditor struct {
+	w     io.Writer
+	mutex *sync.Mutex
+}
+
+// NewJSONAuditor creates a JSONAuditor that appends to the path file, "-" means stdout
+func NewJSONAuditor(path string) (*JSONAuditor, error) {
+	j := &JSONAuditor{
+		w:     os.Stdout,
+		mutex: &sync.Mutex{},
+	}
+
+	if path != auditStdout {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+		if err != nil {
+			return nil, fmt.Errorf("error opening audit log: %s", err)
+		}
+		j.w = f
+	}
+	return j, nil
+}
+
+// Audit will write the records, one per line
+func (j *JSONAuditor) Audit(records ...*AuditRecord) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for _, r := range records {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		// One write per record so the lines don't get mixed
+		if _, err := j.w.Write(append(b, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuditQuery is the filter used to query the audit log, the empty fields don't filter
+type AuditQuery struct {
+	InstanceID string
+	Since      time.Time
+	Until      time.Time
+}
+
+// match checks if the record matches the query
+func (q AuditQuery) match(r *AuditRecord) bool {
+	if q.InstanceID != "" && q.InstanceID != r.InstanceID {
+		return false
+	}
+	if !q.Since.IsZero() && r.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && r.Time.After(q.Until) {
+		return false
+	}
+	return true
+}
+
+// QueryAudit will read the audit log and write the matching records on w
+func QueryAudit(r io.Reader, w io.Writer, q AuditQuery) (int, error) {
+	total := 0
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		rec := &AuditRecord{}
+		if err := json.Unmarshal(line, rec); err != nil {
+			return total, fmt.Errorf("wrong audit record: %s", err)
+		}
+		if !q.match(rec) {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
+			return total, err
+		}
+		total++
+	}
+	return total, s.Err()
+}
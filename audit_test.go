@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testAuditor struct {
+	records []*AuditRecord
+}
+
+func (t *testAuditor) Audit(records ...*AuditRecord) error {
+	t.records = append(t.records, records...)
+	return nil
+}
+
+func TestJSONAuditorAudit(t *testing.T) {
+	b := &bytes.Buffer{}
+	j := &JSONAuditor{
+		w:     b,
+		mutex: &sync.Mutex{},
+	}
+
+	err := j.Audit(
+		&AuditRecord{InstanceID: "i-1", Action: auditActionCreateTags},
+		&AuditRecord{InstanceID: "i-2", Action: auditActionTerminateInstances},
+	)
+	if err != nil {
+		t.Errorf("Audit shouldn't give an error: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Wrong number of audit lines; got: %d, want: %d", len(lines), 2)
+	}
+
+	r := &AuditRecord{}
+	if err := json.Unmarshal([]byte(lines[1]), r); err != nil {
+		t.Fatalf("Audit line should be JSON: %s", err)
+	}
+	if r.InstanceID != "i-2" || r.Action != auditActionTerminateInstances {
+		t.Errorf("Wrong audit record; got: %+v", r)
+	}
+}
+
+func TestQueryAudit(t *testing.T) {
+	now := time.Now().UTC()
+	b := &bytes.Buffer{}
+	j := &JSONAuditor{w: b, mutex: &sync.Mutex{}}
+	j.Audit(
+		&AuditRecord{Time: now.Add(-2 * time.Hour), InstanceID: "i-1"},
+		&AuditRecord{Time: now.Add(-1 * time.Hour), InstanceID: "i-2"},
+		&AuditRecord{Time: now, InstanceID: "i-1"},
+	)
+	log := b.String()
+
+	tests := []struct {
+		query AuditQuery
+
+		want int
+	}{
+		{AuditQuery{}, 3},
+		{AuditQuery{InstanceID: "i-1"}, 2},
+		{AuditQuery{InstanceID: "i-3"}, 0},
+		{AuditQuery{Since: now.Add(-90 * time.Minute)}, 2},
+		{AuditQuery{Until: now.Add(-90 * time.Minute)}, 1},
+		{AuditQuery{InstanceID: "i-1", Since: now.Add(-90 * time.Minute)}, 1},
+	}
+
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		got, err := QueryAudit(strings.NewReader(log), out, test.query)
+		if err != nil {
+			t.Errorf("%+v\n- Query shouldn't give an error: %s", test, err)
+		}
+		if got != test.want {
+			t.Errorf("%+v\n- Wrong number of records; got: %d, want: %d", test, got, test.want)
+		}
+		if strings.Count(out.String(), "\n") != test.want {
+			t.Errorf("%+v\n- Wrong number of written records; got: %d, want: %d", test, strings.Count(out.String(), "\n"), test.want)
+		}
+	}
+}
+
+func TestQueryAuditWrongRecord(t *testing.T) {
+	_, err := QueryAudit(strings.NewReader("{wrong\n"), &bytes.Buffer{}, AuditQuery{})
+	if err == nil {
+		t.Errorf("Query should give an error, it didn't")
+	}
+}
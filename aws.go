@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// newAWSSession creates an AWS session that logs every API call with its latency at debug level
+func newAWSSession(awsRegion string) (*session.Session, error) {
+	s := session.New(&aws.Config{Region: aws.String(awsRegion)})
+	if s == nil {
+		return nil, fmt.Errorf("error creating aws session")
+	}
+
+	// The clients copy the session handlers, this needs to be set before creating them
+	s.Handlers.Send.PushBack(logAWSRequest)
+
+	return s, nil
+}
+
+// logAWSRequest logs the sent AWS API request operation and latency
+func logAWSRequest(r *request.Request) {
+	log := logrus.WithFields(logrus.Fields{
+		"service":    r.ClientInfo.ServiceName,
+		"operation":  r.Operation.Name,
+		"latency_ms": time.Since(r.Time).Nanoseconds() / int64(time.Millisecond),
+		"retry":      r.RetryCount,
+	})
+	if r.Error != nil {
+		log = log.WithError(r.Error)
+	}
+	log.Debug("AWS API call")
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestLogAWSRequest(t *testing.T) {
+	tests := []struct {
+		err error
+
+		wantError bool
+	}{
+		{nil, false},
+		{errors.New("wrong"), true},
+	}
+
+	// Capture the logs
+	b := &bytes.Buffer{}
+	logrus.SetOutput(b)
+	logrus.SetFormatter(&logrus.JSONFormatter{})
+	logrus.SetLevel(logrus.DebugLevel)
+	defer func() {
+		logrus.SetOutput(os.Stderr)
+		logrus.SetFormatter(&logrus.TextFormatter{})
+		logrus.SetLevel(logrus.InfoLevel)
+	}()
+
+	for _, test := range tests {
+		b.Reset()
+		r := &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceName: "ecs"},
+			Operation:  &request.Operation{Name: "DescribeContainerInstances"},
+			Time:       time.Now().Add(-1 * time.Second),
+			Error:      test.err,
+		}
+		logAWSRequest(r)
+
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(b.Bytes(), &fields); err != nil {
+			t.Fatalf("%+v\n- Log should be JSON: %s", test, err)
+		}
+		if fields["operation"] != "DescribeContainerInstances" {
+			t.Errorf("%+v\n- Wrong operation log field; got: %v", test, fields["operation"])
+		}
+		if l, ok := fields["latency_ms"].(float64); !ok || l < 1000 {
+			t.Errorf("%+v\n- Wrong latency log field; got: %v", test, fields["latency_ms"])
+		}
+		if _, ok := fields["error"]; ok != test.wantError {
+			t.Errorf("%+v\n- Wrong error log field; got: %v", test, fields["error"])
+		}
+	}
+}
@@ -17,11 +17,57 @@ import (
 
 const (
 	checkMaxAWSAPIResult = 50
+
+	// The number of agent connection observations kept per instance
+	checkAgentHistorySize = 10
+
+	// The tag with the reasons of the checkers that declared the marked instance unhealthy
+	unhealthyReasonTagKey = "ecs-watcher:unhealthy-reason"
+
+	// The maximum number of characters of an EC2 tag value
+	maxTagValueLength = 255
 )
 
 type unhealthyInstance struct {
 	instance *ecs.ContainerInstance
 	started  time.Time
+
+	// The verdicts that declared the instance unhealthy
+	verdicts []*Verdict
+}
+
+// markAfter returns the time the instance needs to be unhealthy before marking it, all the
+// verdicts share the same timer so the shortest threshold of them wins
+func (u *unhealthyInstance) markAfter(defaultAfter time.Duration) time.Duration {
+	after := time.Duration(-1)
+	for _, v := range u.verdicts {
+		d := v.After
+		if d == 0 {
+			d = defaultAfter
+		}
+		if after < 0 || d < after {
+			after = d
+		}
+	}
+	if after < 0 {
+		return defaultAfter
+	}
+	return after
+}
+
+// ClusterSnapshot is the state of the cluster fetched on each check iteration
+type ClusterSnapshot struct {
+	Time               time.Time
+	ContainerInstances []*ecs.ContainerInstance
+}
+
+// byInstanceID returns the snapshot container instances by EC2 instance ID
+func (c *ClusterSnapshot) byInstanceID() map[string]*ecs.ContainerInstance {
+	cis := make(map[string]*ecs.ContainerInstance, len(c.ContainerInstances))
+	for _, ci := range c.ContainerInstances {
+		cis[aws.StringValue(ci.Ec2InstanceId)] = ci
+	}
+	return cis
 }
 
 // AgentChecker will check the agent status on the ECS cluster instances
@@ -42,15 +88,26 @@ type AgentChecker struct {
 
 	// The time to wait before marking an unhealthy instance
 	markAfter time.Duration
+
+	// The last agent connection observations of each instance
+	history map[string][]agentStatus
+
+	// The auditor of the marking actions
+	auditor Auditor
+
+	// The checker that decides the unhealthy instances, by default the agent connected one
+	checker InstanceChecker
 }
 
 // NewAgentChecker creates an AgentChecker
-func NewAgentChecker(clusterName string, awsRegion string, tag string, markAfter time.Duration) (*AgentChecker, error) {
+func NewAgentChecker(clusterName string, awsRegion string, tag string, markAfter time.Duration, auditor Auditor) (*AgentChecker, error) {
 	a := &AgentChecker{
 		clusterName:      clusterName,
 		unhealthies:      make(map[string]*unhealthyInstance),
 		unhealthiesMutex: &sync.Mutex{},
 		markAfter:        markAfter,
+		history:          make(map[string][]agentStatus),
+		auditor:          auditor,
 	}
 
 	// Set the tag
@@ -58,9 +115,9 @@ func NewAgentChecker(clusterName string, awsRegion string, tag string, markAfter
 	a.markTag = MarkTag{splTag[0], splTag[1]}
 
 	// Create AWS session
-	s := session.New(&aws.Config{Region: aws.String(awsRegion)})
-	if s == nil {
-		return nil, fmt.Errorf("error creating aws session")
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
 	}
 	a.session = s
 
@@ -74,18 +131,15 @@ func NewAgentChecker(clusterName string, awsRegion string, tag string, markAfter
 	return a, nil
 }
 
-// Check will check if the agent is connected in each instance
-func (a *AgentChecker) Check() error {
-
-	logrus.Debugf("Getting cluster instance arns")
-
+// describeContainerInstances returns all the container instances of the cluster
+func describeContainerInstances(cli ecsiface.ECSAPI, clusterName string) ([]*ecs.ContainerInstance, error) {
 	// Get the container instance ARNs
 	lparams := &ecs.ListContainerInstancesInput{
-		Cluster:    aws.String(a.clusterName),
+		Cluster:    aws.String(clusterName),
 		MaxResults: aws.Int64(checkMaxAWSAPIResult),
 	}
 	var arns []*string
-	err := a.ecsCli.ListContainerInstancesPages(lparams,
+	err := cli.ListContainerInstancesPages(lparams,
 		func(page *ecs.ListContainerInstancesOutput, lastPage bool) bool {
 			// Append the arns
 			arns = append(arns, page.ContainerInstanceArns...)
@@ -93,21 +147,55 @@ func (a *AgentChecker) Check() error {
 		})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(arns) == 0 {
-		logrus.Warningf("No container instances present")
-		return nil
+		return nil, nil
 	}
 
-	logrus.Debugf("Got %d container instance ARNs", len(arns))
-
 	// Check the status of the container instances
 	dparams := &ecs.DescribeContainerInstancesInput{
 		ContainerInstances: arns,
-		Cluster:            aws.String(a.clusterName),
+		Cluster:            aws.String(clusterName),
 	}
-	resp, err := a.ecsCli.DescribeContainerInstances(dparams)
+	resp, err := cli.DescribeContainerInstances(dparams)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ContainerInstances, nil
+}
+
+// instanceChecker returns the checker that decides the unhealthy instances
+func (a *AgentChecker) instanceChecker() InstanceChecker {
+	if a.checker == nil {
+		return &AgentConnectedChecker{}
+	}
+	return a.checker
+}
+
+// Check will check the health of each instance
+func (a *AgentChecker) Check() error {
+	log := componentLog(a.clusterName, componentChecker)
+
+	log.Debug("Getting cluster container instances")
+
+	cis, err := describeContainerInstances(a.ecsCli, a.clusterName)
+	if err != nil {
+		return err
+	}
+	// Without container instances the unhealthy ones are gone, continue with an empty snapshot
+	if len(cis) == 0 {
+		log.Warning("No container instances present")
+	}
+
+	log.WithField("total", len(cis)).Debug("Got container instances")
+
+	snapshot := &ClusterSnapshot{
+		Time:               time.Now().UTC(),
+		ContainerInstances: cis,
+	}
+
+	verdicts, err := a.instanceChecker().Unhealthy(snapshot)
 	if err != nil {
 		return err
 	}
@@ -116,6 +204,17 @@ func (a *AgentChecker) Check() error {
 	// removal, so we can't use the unhelty total as the cluster unhealthy total number
 	a.unhealthiesMutex.Lock()
 
+	// The history of the instances that aren't on the cluster anymore is dropped
+	newHistory := make(map[string][]agentStatus)
+	for _, ci := range snapshot.ContainerInstances {
+		id := aws.StringValue(ci.Ec2InstanceId)
+		h := append(a.history[id], agentStatus{At: snapshot.Time, Connected: aws.BoolValue(ci.AgentConnected)})
+		if len(h) > checkAgentHistorySize {
+			h = h[len(h)-checkAgentHistorySize:]
+		}
+		newHistory[id] = h
+	}
+
 	// We are setting here the actual state of the unhealthy ones, but before storing them here
 	// will check if there where already in the past check iteration, if is there then copy with
 	// the started timestamp, if isn't there then a new one.
@@ -123,72 +222,204 @@ func (a *AgentChecker) Check() error {
 	// because the unhealthy instances need to be unhealthy for X duration (configured in unhealthy.after)
 	newUnhealthies := make(map[string]*unhealthyInstance)
 	// Save the unhealthy ones
-	for _, ci := range resp.ContainerInstances {
-		// if ok don't do nothing
-		if aws.BoolValue(ci.AgentConnected) {
-			continue
-		}
-
-		// TODO: Check status of the instance?
-
+	byID := snapshot.byInstanceID()
+	for id, vs := range verdicts {
 		ui := &unhealthyInstance{
-			instance: ci,
-			started:  time.Now().UTC(),
+			instance: byID[id],
+			started:  snapshot.Time,
+			verdicts: vs,
 		}
 
 		// The instance seems unhealthy already from previous iterations, set the
 		// started timestamp to the correct one (when it realy started, not now)
-		if v, ok := a.unhealthies[aws.StringValue(ci.Ec2InstanceId)]; ok {
+		if v, ok := a.unhealthies[id]; ok {
 			ui.started = v.started
 		}
-		newUnhealthies[aws.StringValue(ci.Ec2InstanceId)] = ui
-
+		newUnhealthies[id] = ui
+
+		for _, v := range vs {
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: id,
+				"checker":          v.Checker,
+				"reason":           v.Reason,
+			}).Debug("Unhealthy instance")
+		}
 	}
 	a.unhealthies = newUnhealthies
+	a.history = newHistory
 	a.unhealthiesMutex.Unlock()
 
-	logrus.Infof("%d total unhealthy", len(a.unhealthies))
+	log.WithField("unhealthy", len(a.unhealthies)).Info("Checked cluster instances")
 
 	return nil
 }
 
-// Mark will mark them as unhealthy
+// Mark will mark them as unhealthy, the ones that only need to leave the cluster are deregistered
 func (a *AgentChecker) Mark() error {
+	log := componentLog(a.clusterName, componentChecker)
 	a.unhealthiesMutex.Lock()
 	defer a.unhealthiesMutex.Unlock()
 
 	var resources []*string
+	var deregister []*string
 
 	for id, v := range a.unhealthies {
 		// Check if we need to mark the unhelthies
 		t := time.Now().UTC().Sub(v.started)
-		if t >= a.markAfter {
-			resources = append(resources, aws.String(id))
+		if t < v.markAfter(a.markAfter) {
+			continue
 		}
+		if deregisterOnly(v.verdicts) && containerInstanceArn(v.instance) != nil {
+			deregister = append(deregister, aws.String(id))
+			continue
+		}
+		resources = append(resources, aws.String(id))
+	}
+
+	if err := a.deregister(deregister); err != nil {
+		return err
 	}
 
 	if len(resources) == 0 {
-		logrus.Debugf("Skipping marking, no unhealthy instances")
+		log.Debug("Skipping marking, no unhealthy instances")
 		return nil
 	}
 
-	// mark all the unhealthy images
-	params := &ec2.CreateTagsInput{
-		Resources: resources,
-		Tags: []*ec2.Tag{
-			{Key: aws.String(a.markTag.key), Value: aws.String(a.markTag.value)},
-		},
+	// mark all the unhealthy instances, each one with the reasons of its verdicts
+	byReason := map[string][]*string{}
+	var reasons []string
+	for _, id := range resources {
+		r := verdictsReason(a.unhealthies[aws.StringValue(id)].verdicts)
+		if _, ok := byReason[r]; !ok {
+			reasons = append(reasons, r)
+		}
+		byReason[r] = append(byReason[r], id)
 	}
-	_, err := a.ec2Cli.CreateTags(params)
-	if err != nil {
-		return err
+	for _, r := range reasons {
+		ids := byReason[r]
+		params := &ec2.CreateTagsInput{
+			Resources: ids,
+			Tags: []*ec2.Tag{
+				{Key: aws.String(a.markTag.key), Value: aws.String(a.markTag.value)},
+			},
+		}
+		if r != "" {
+			params.Tags = append(params.Tags, &ec2.Tag{Key: aws.String(unhealthyReasonTagKey), Value: aws.String(r)})
+		}
+		_, err := a.ec2Cli.CreateTags(params)
+		a.audit(auditActionCreateTags, ids, err)
+		if err != nil {
+			return err
+		}
+
+		// We are good to remove from the unhealthy ones, they are already marked
+		for _, i := range ids {
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: aws.StringValue(i),
+				logFieldAction:     auditActionCreateTags,
+				"reason":           r,
+			}).Info("Marked unhealthy instance")
+			delete(a.unhealthies, aws.StringValue(i))
+		}
 	}
 
-	// We are good to remove from the unhealthy ones, they are already marked
-	for _, i := range resources {
-		delete(a.unhealthies, aws.StringValue(i))
+	return nil
+}
+
+// verdictsReason returns the checkers and reasons of the verdicts as a tag value
+func verdictsReason(verdicts []*Verdict) string {
+	rs := make([]string, len(verdicts))
+	for i, v := range verdicts {
+		rs[i] = fmt.Sprintf("%s: %s", v.Checker, v.Reason)
 	}
-	logrus.Infof("Marked %d", len(resources))
+	r := []rune(strings.Join(rs, "; "))
+	if len(r) > maxTagValueLength {
+		r = r[:maxTagValueLength]
+	}
+	return string(r)
+}
+
+// deregister will deregister the container instances from the cluster, the EC2 instances
+// aren't running so there is nothing to clean by the garbage collector
+func (a *AgentChecker) deregister(ids []*string) error {
+	log := componentLog(a.clusterName, componentChecker)
 
+	for _, id := range ids {
+		params := &ecs.DeregisterContainerInstanceInput{
+			Cluster:           aws.String(a.clusterName),
+			ContainerInstance: containerInstanceArn(a.unhealthies[aws.StringValue(id)].instance),
+			Force:             aws.Bool(true),
+		}
+		_, err := a.ecsCli.DeregisterContainerInstance(params)
+		a.audit(auditActionDeregisterContainerInstance, []*string{id}, err)
+		if err != nil {
+			return err
+		}
+
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: aws.StringValue(id),
+			logFieldAction:     auditActionDeregisterContainerInstance,
+		}).Info("Deregistered stale container instance")
+		delete(a.unhealthies, aws.StringValue(id))
+	}
 	return nil
 }
+
+// audit will record the marking of the instances with the evidence of being unhealthy
+func (a *AgentChecker) audit(action string, ids []*string, actionErr error) {
+	if a.auditor == nil {
+		return
+	}
+
+	records := make([]*AuditRecord, len(ids))
+	for i, id := range ids {
+		r := &AuditRecord{
+			Time:       time.Now().UTC(),
+			Cluster:    a.clusterName,
+			Component:  componentChecker,
+			Action:     action,
+			InstanceID: aws.StringValue(id),
+			Tags:       map[string]string{a.markTag.key: a.markTag.value},
+			Evidence: AuditEvidence{
+				AgentConnectedHistory: a.history[aws.StringValue(id)],
+				Thresholds:            map[string]string{"unhealthy.after": a.markAfter.String()},
+			},
+		}
+		if v, ok := a.unhealthies[aws.StringValue(id)]; ok {
+			started := v.started
+			r.Evidence.FirstUnhealthy = &started
+			r.Evidence.Verdicts = v.verdicts
+			if after := v.markAfter(a.markAfter); after != a.markAfter {
+				r.Evidence.Thresholds["verdict.after"] = after.String()
+			}
+		}
+		if actionErr != nil {
+			r.Error = actionErr.Error()
+		}
+		records[i] = r
+	}
+
+	if err := a.auditor.Audit(records...); err != nil {
+		componentLog(a.clusterName, componentChecker).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
+
+// AgentConnectedChecker will flag the instances that have the ECS agent disconnected
+type AgentConnectedChecker struct{}
+
+// Unhealthy returns the instances with the agent disconnected
+func (c *AgentConnectedChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	for _, ci := range snapshot.ContainerInstances {
+		// if ok don't do nothing
+		if aws.BoolValue(ci.AgentConnected) {
+			continue
+		}
+
+		verdicts.add(aws.StringValue(ci.Ec2InstanceId), &Verdict{
+			Checker: agentConnectedCheckerName,
+			Reason:  "ECS agent disconnected",
+		})
+	}
+	return verdicts, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+var versionRegexp = regexp.MustCompile(`[0-9]+(\.[0-9]+)*`)
+
+// AgentVersionChecker will flag the instances with an ECS agent or docker older than the
+// minimum versions, or with an agent update that failed or is stuck staging
+type AgentVersionChecker struct {
+	// The minimum versions, empty means any version
+	minAgentVersion  string
+	minDockerVersion string
+
+	// The duration an agent update needs to be staging to mark the instance, staging is
+	// part of a normal update
+	stagingAfter time.Duration
+}
+
+// NewAgentVersionChecker creates an AgentVersionChecker
+func NewAgentVersionChecker(minAgentVersion, minDockerVersion string, stagingAfter time.Duration) *AgentVersionChecker {
+	return &AgentVersionChecker{
+		minAgentVersion:  minAgentVersion,
+		minDockerVersion: minDockerVersion,
+		stagingAfter:     stagingAfter,
+	}
+}
+
+// Unhealthy returns the instances with outdated versions or broken agent updates, the
+// stuck ones are marked after being on the same state for the unhealthy duration
+func (c *AgentVersionChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	for _, ci := range snapshot.ContainerInstances {
+		id := aws.StringValue(ci.Ec2InstanceId)
+
+		switch s := aws.StringValue(ci.AgentUpdateStatus); s {
+		case ecs.AgentUpdateStatusFailed:
+			verdicts.add(id, &Verdict{
+				Checker: agentVersionCheckerName,
+				Reason:  fmt.Sprintf("ECS agent update %s", s),
+			})
+		case ecs.AgentUpdateStatusStaging:
+			verdicts.add(id, &Verdict{
+				Checker: agentVersionCheckerName,
+				Reason:  fmt.Sprintf("ECS agent update %s", s),
+				After:   c.stagingAfter,
+			})
+		}
+
+		if ci.VersionInfo == nil {
+			continue
+		}
+
+		if v := aws.StringValue(ci.VersionInfo.AgentVersion); c.minAgentVersion != "" && compareVersions(v, c.minAgentVersion) < 0 {
+			verdicts.add(id, &Verdict{
+				Checker: agentVersionCheckerName,
+				Reason:  fmt.Sprintf("ECS agent version %s older than %s", v, c.minAgentVersion),
+			})
+		}
+
+		if v := aws.StringValue(ci.VersionInfo.DockerVersion); c.minDockerVersion != "" && compareVersions(v, c.minDockerVersion) < 0 {
+			verdicts.add(id, &Verdict{
+				Checker: agentVersionCheckerName,
+				Reason:  fmt.Sprintf("Docker version %s older than %s", v, c.minDockerVersion),
+			})
+		}
+	}
+	return verdicts, nil
+}
+
+// compareVersions compares the first dotted number of the versions, so "DockerVersion: 1.11.2"
+// is 1.11.2, it returns -1 if a is older than b, 1 if is newer and 0 if they are the same
+func compareVersions(a, b string) int {
+	as := strings.Split(versionRegexp.FindString(a), ".")
+	bs := strings.Split(versionRegexp.FindString(b), ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+	}
+	return 0
+}
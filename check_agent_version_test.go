@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+
+		want int
+	}{
+		{"1.12.1", "1.12.1", 0},
+		{"1.12.1", "1.12.2", -1},
+		{"1.13.0", "1.12.2", 1},
+		{"1.9.0", "1.12.0", -1},
+		{"1.12", "1.12.0", 0},
+		{"DockerVersion: 1.11.2", "1.12.0", -1},
+		{"DockerVersion: 1.12.6", "1.12.0", 1},
+	}
+
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.want {
+			t.Errorf("%+v\n- Wrong comparison; got: %d, want: %d", test, got, test.want)
+		}
+	}
+}
+
+func TestAgentVersionChecker(t *testing.T) {
+	tests := []struct {
+		agentVersion  string
+		dockerVersion string
+		updateStatus  string
+
+		wantVerdicts int
+	}{
+		{"1.12.1", "DockerVersion: 1.11.2", "", 0},
+		{"1.11.0", "DockerVersion: 1.11.2", "", 1},
+		{"1.12.1", "DockerVersion: 1.9.1", "", 1},
+		{"1.11.0", "DockerVersion: 1.9.1", "", 2},
+		{"1.12.1", "DockerVersion: 1.11.2", ecs.AgentUpdateStatusFailed, 1},
+		{"1.12.1", "DockerVersion: 1.11.2", ecs.AgentUpdateStatusStaging, 1},
+		{"1.12.1", "DockerVersion: 1.11.2", ecs.AgentUpdateStatusUpdated, 0},
+	}
+
+	for _, test := range tests {
+		ci := &ecs.ContainerInstance{
+			Ec2InstanceId: aws.String("i-0"),
+			VersionInfo: &ecs.VersionInfo{
+				AgentVersion:  aws.String(test.agentVersion),
+				DockerVersion: aws.String(test.dockerVersion),
+			},
+		}
+		if test.updateStatus != "" {
+			ci.AgentUpdateStatus = aws.String(test.updateStatus)
+		}
+
+		c := NewAgentVersionChecker("1.12.0", "1.11.0", time.Hour)
+		vs, err := c.Unhealthy(&ClusterSnapshot{ContainerInstances: []*ecs.ContainerInstance{ci}})
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+
+		if len(vs["i-0"]) != test.wantVerdicts {
+			t.Errorf("%+v\n- Wrong number of verdicts; got: %d, want: %d", test, len(vs["i-0"]), test.wantVerdicts)
+		}
+		for _, v := range vs["i-0"] {
+			if v.Checker != agentVersionCheckerName {
+				t.Errorf("%+v\n- Wrong verdict checker; got: %s, want: %s", test, v.Checker, agentVersionCheckerName)
+			}
+			// Staging is part of an update, it needs to be stuck to mark the instance
+			wantAfter := time.Duration(0)
+			if test.updateStatus == ecs.AgentUpdateStatusStaging {
+				wantAfter = time.Hour
+			}
+			if v.After != wantAfter {
+				t.Errorf("%+v\n- Wrong verdict after; got: %s, want: %s", test, v.After, wantAfter)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// requiredAttribute is a container instance attribute required on the instances of a group, an
+// empty group means all the instances and an empty value means any value
+type requiredAttribute struct {
+	group string
+	name  string
+	value string
+}
+
+func (r requiredAttribute) String() string {
+	s := r.name
+	if r.value != "" {
+		s = fmt.Sprintf("%s=%s", s, r.value)
+	}
+	if r.group != "" {
+		s = fmt.Sprintf("%s:%s", r.group, s)
+	}
+	return s
+}
+
+// requiredAttributeList is a comma separated list flag of [group:]name[=value] attributes
+type requiredAttributeList []requiredAttribute
+
+func (r *requiredAttributeList) String() string {
+	attrs := make([]string, len(*r))
+	for i, a := range *r {
+		attrs[i] = a.String()
+	}
+	return strings.Join(attrs, ",")
+}
+
+func (r *requiredAttributeList) Set(v string) error {
+	*r = requiredAttributeList{}
+	for _, attr := range strings.Split(v, ",") {
+		attr = strings.TrimSpace(attr)
+		if attr == "" {
+			continue
+		}
+
+		// The value can have any character, the group is split from the name
+		orig := attr
+		ra := requiredAttribute{}
+		if i := strings.Index(attr, "="); i >= 0 {
+			attr, ra.value = attr[:i], attr[i+1:]
+		}
+		if i := strings.LastIndex(attr, ":"); i >= 0 {
+			ra.group, attr = attr[:i], attr[i+1:]
+		}
+		ra.name = attr
+		if ra.name == "" {
+			return fmt.Errorf("wrong required attribute %q, the name is missing", orig)
+		}
+		*r = append(*r, ra)
+	}
+	return nil
+}
+
+// AttributesChecker will flag the instances that miss any of the required attributes, the
+// tasks with placement constraints can't be placed on them
+type AttributesChecker struct {
+	// The required attributes
+	required []requiredAttribute
+
+	// The attribute with the group of the instance, the required attributes of a group only apply to its instances
+	groupAttribute string
+}
+
+// NewAttributesChecker creates an AttributesChecker
+func NewAttributesChecker(required []requiredAttribute, groupAttribute string) *AttributesChecker {
+	return &AttributesChecker{
+		required:       required,
+		groupAttribute: groupAttribute,
+	}
+}
+
+// Unhealthy returns the instances missing required attributes
+func (c *AttributesChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	for _, ci := range snapshot.ContainerInstances {
+		group := ""
+		if c.groupAttribute != "" {
+			group, _ = instanceAttribute(ci, c.groupAttribute)
+		}
+
+		var missing []string
+		for _, ra := range c.required {
+			if ra.group != "" && ra.group != group {
+				continue
+			}
+			v, ok := instanceAttribute(ci, ra.name)
+			if !ok || (ra.value != "" && v != ra.value) {
+				missing = append(missing, ra.String())
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		verdicts.add(aws.StringValue(ci.Ec2InstanceId), &Verdict{
+			Checker: attributesCheckerName,
+			Reason:  fmt.Sprintf("missing required attributes: %s", strings.Join(missing, ",")),
+		})
+	}
+	return verdicts, nil
+}
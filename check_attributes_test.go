@@ -0,0 +1,100 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestRequiredAttributeListFlag(t *testing.T) {
+	tests := []struct {
+		flag string
+
+		want    string
+		wantErr bool
+	}{
+		{"com.amazonaws.ecs.capability.logging-driver.awslogs", "com.amazonaws.ecs.capability.logging-driver.awslogs", false},
+		{"a, web:b=1 ,c=2", "a,web:b=1,c=2", false},
+		{"web:", "", true},
+		{"=1", "", true},
+		{"web:=1:2", "", true},
+	}
+
+	for _, test := range tests {
+		var r requiredAttributeList
+		err := r.Set(test.flag)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%+v\n- Set should give an error, it didn't", test)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v\n- Set shouldn't give an error: %s", test, err)
+		}
+		if got := r.String(); got != test.want {
+			t.Errorf("%+v\n- Wrong required attributes; got: %s, want: %s", test, got, test.want)
+		}
+	}
+
+	// The values can have the group separator
+	var r requiredAttributeList
+	if err := r.Set("web:endpoint=http://proxy:3128,zone=eu-west-1:a"); err != nil {
+		t.Fatalf("Set shouldn't give an error: %s", err)
+	}
+	want := requiredAttributeList{
+		{group: "web", name: "endpoint", value: "http://proxy:3128"},
+		{name: "zone", value: "eu-west-1:a"},
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("Wrong required attributes; got: %+v, want: %+v", r, want)
+	}
+}
+
+func TestAttributesChecker(t *testing.T) {
+	var required requiredAttributeList
+	required.Set("awslogs,web:stack=prod,batch:gpu")
+
+	attrs := func(kv ...string) []*ecs.Attribute {
+		var res []*ecs.Attribute
+		for i := 0; i < len(kv); i = i + 2 {
+			a := &ecs.Attribute{Name: aws.String(kv[i])}
+			if kv[i+1] != "" {
+				a.Value = aws.String(kv[i+1])
+			}
+			res = append(res, a)
+		}
+		return res
+	}
+
+	tests := []struct {
+		attributes []*ecs.Attribute
+
+		wantVerdicts int
+	}{
+		{attrs("awslogs", ""), 0},
+		{attrs(), 1},
+		{attrs("awslogs", "", "group", "web", "stack", "prod"), 0},
+		{attrs("awslogs", "", "group", "web", "stack", "dev"), 1},
+		{attrs("awslogs", "", "group", "web"), 1},
+		{attrs("group", "batch"), 1},
+		{attrs("awslogs", "", "group", "batch", "gpu", ""), 0},
+	}
+
+	for _, test := range tests {
+		ci := &ecs.ContainerInstance{
+			Ec2InstanceId: aws.String("i-0"),
+			Attributes:    test.attributes,
+		}
+		c := NewAttributesChecker(required, "group")
+		vs, err := c.Unhealthy(&ClusterSnapshot{ContainerInstances: []*ecs.ContainerInstance{ci}})
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+		if len(vs["i-0"]) != test.wantVerdicts {
+			t.Errorf("%+v\n- Wrong number of verdicts; got: %d, want: %d", test, len(vs["i-0"]), test.wantVerdicts)
+		}
+	}
+}
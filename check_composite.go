@@ -0,0 +1,75 @@
+package main
+
+// Composite checker modes
+const (
+	// checkModeAny declares an instance unhealthy when any of the checkers does
+	checkModeAny = "any"
+	// checkModeAll declares an instance unhealthy when all the checkers do
+	checkModeAll = "all"
+)
+
+// CompositeChecker runs multiple checkers against the same cluster snapshot and
+// combines their verdicts
+type CompositeChecker struct {
+	// the name of the cluster
+	clusterName string
+
+	// How the verdicts are combined, any or all
+	mode string
+
+	checkers []InstanceChecker
+
+	// The verdicts of the last successful run of each checker
+	last map[int]Verdicts
+}
+
+// NewCompositeChecker creates a CompositeChecker
+func NewCompositeChecker(clusterName string, mode string, checkers ...InstanceChecker) *CompositeChecker {
+	return &CompositeChecker{
+		clusterName: clusterName,
+		mode:        mode,
+		checkers:    checkers,
+		last:        map[int]Verdicts{},
+	}
+}
+
+// Unhealthy returns the combined verdicts of all the checkers, a failing checker doesn't stop
+// the others and its last verdicts are used instead, so the instances it flagged don't reset
+// their unhealthy time. If a failing checker didn't succeed before the error is returned and
+// the round is skipped
+func (c *CompositeChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	log := componentLog(c.clusterName, componentChecker)
+
+	// The number of checkers that declared each instance unhealthy
+	flagged := map[string]int{}
+	all := Verdicts{}
+	for i, ch := range c.checkers {
+		vs, err := ch.Unhealthy(snapshot)
+		if err != nil {
+			last, ok := c.last[i]
+			if !ok {
+				return nil, err
+			}
+			log.WithError(err).Error("Error running checker, using its last verdicts")
+			vs = last
+		} else {
+			c.last[i] = vs
+		}
+		for id, v := range vs {
+			all.add(id, v...)
+			flagged[id]++
+		}
+	}
+
+	if c.mode != checkModeAll {
+		return all, nil
+	}
+
+	verdicts := Verdicts{}
+	for id, v := range all {
+		if flagged[id] == len(c.checkers) {
+			verdicts[id] = v
+		}
+	}
+	return verdicts, nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+type testInstanceChecker struct {
+	name      string
+	unhealthy []string
+	err       bool
+}
+
+func (t *testInstanceChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	if t.err {
+		return nil, errors.New("")
+	}
+	v := Verdicts{}
+	for _, id := range t.unhealthy {
+		v.add(id, &Verdict{Checker: t.name, Reason: "test"})
+	}
+	return v, nil
+}
+
+func TestCompositeChecker(t *testing.T) {
+	tests := []struct {
+		mode     string
+		checkers []InstanceChecker
+
+		wantUnhealthy map[string]int
+		wantError     bool
+	}{
+		{
+			mode: checkModeAny,
+			checkers: []InstanceChecker{
+				&testInstanceChecker{name: "a", unhealthy: []string{"i-1", "i-2"}},
+				&testInstanceChecker{name: "b", unhealthy: []string{"i-2", "i-3"}},
+			},
+			wantUnhealthy: map[string]int{"i-1": 1, "i-2": 2, "i-3": 1},
+		},
+		{
+			mode: checkModeAll,
+			checkers: []InstanceChecker{
+				&testInstanceChecker{name: "a", unhealthy: []string{"i-1", "i-2"}},
+				&testInstanceChecker{name: "b", unhealthy: []string{"i-2", "i-3"}},
+			},
+			wantUnhealthy: map[string]int{"i-2": 2},
+		},
+		{
+			mode: checkModeAny,
+			checkers: []InstanceChecker{
+				&testInstanceChecker{name: "a", unhealthy: []string{"i-1"}},
+				&testInstanceChecker{name: "b", err: true},
+			},
+			wantError: true,
+		},
+		{
+			mode: checkModeAll,
+			checkers: []InstanceChecker{
+				&testInstanceChecker{name: "a", unhealthy: []string{"i-1"}},
+				&testInstanceChecker{name: "b", err: true},
+			},
+			wantError: true,
+		},
+		{
+			mode: checkModeAny,
+			checkers: []InstanceChecker{
+				&testInstanceChecker{name: "a", err: true},
+				&testInstanceChecker{name: "b", err: true},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		c := NewCompositeChecker("test", test.mode, test.checkers...)
+		vs, err := c.Unhealthy(&ClusterSnapshot{})
+		if test.wantError {
+			if err == nil {
+				t.Errorf("%+v\n- Unhealthy should give an error, it didn't", test)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+
+		if len(vs) != len(test.wantUnhealthy) {
+			t.Errorf("%+v\n- Wrong number of unhealthy instances; got: %d, want: %d", test, len(vs), len(test.wantUnhealthy))
+		}
+		for id, want := range test.wantUnhealthy {
+			if len(vs[id]) != want {
+				t.Errorf("%+v\n- Wrong number of verdicts for %s; got: %d, want: %d", test, id, len(vs[id]), want)
+			}
+		}
+	}
+}
+
+func TestCompositeCheckerLastVerdicts(t *testing.T) {
+	for _, mode := range []string{checkModeAny, checkModeAll} {
+		a := &testInstanceChecker{name: "a", unhealthy: []string{"i-1", "i-2"}}
+		b := &testInstanceChecker{name: "b", unhealthy: []string{"i-2"}}
+		c := NewCompositeChecker("test", mode, a, b)
+
+		if _, err := c.Unhealthy(&ClusterSnapshot{}); err != nil {
+			t.Fatalf("%s\n- Unhealthy shouldn't give an error: %s", mode, err)
+		}
+
+		// The failing checker keeps its verdicts of the previous run
+		b.err = true
+		vs, err := c.Unhealthy(&ClusterSnapshot{})
+		if err != nil {
+			t.Fatalf("%s\n- Unhealthy shouldn't give an error: %s", mode, err)
+		}
+		if len(vs["i-2"]) != 2 {
+			t.Errorf("%s\n- The failing checker verdicts should be kept; got: %d verdicts, want: 2", mode, len(vs["i-2"]))
+		}
+	}
+}
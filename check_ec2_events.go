@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// The scheduled EC2 events that take out the instance
+var ec2ScheduledEventCodes = []string{
+	ec2.EventCodeInstanceRetirement,
+	ec2.EventCodeInstanceStop,
+	ec2.EventCodeSystemReboot,
+}
+
+// EC2EventsChecker will flag the instances that have a scheduled EC2 event, so they are
+// replaced before AWS takes them out
+type EC2EventsChecker struct {
+	ec2Cli ec2iface.EC2API
+}
+
+// NewEC2EventsChecker creates an EC2EventsChecker
+func NewEC2EventsChecker(s *session.Session) *EC2EventsChecker {
+	return &EC2EventsChecker{
+		ec2Cli: ec2.New(s),
+	}
+}
+
+// Unhealthy returns the cluster instances with scheduled events
+func (c *EC2EventsChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	if len(snapshot.ContainerInstances) == 0 {
+		return verdicts, nil
+	}
+	byID := snapshot.byInstanceID()
+
+	params := &ec2.DescribeInstanceStatusInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("event.code"),
+				Values: aws.StringSlice(ec2ScheduledEventCodes),
+			},
+		},
+	}
+	err := c.ec2Cli.DescribeInstanceStatusPages(params,
+		func(page *ec2.DescribeInstanceStatusOutput, lastPage bool) bool {
+			for _, st := range page.InstanceStatuses {
+				id := aws.StringValue(st.InstanceId)
+				if _, ok := byID[id]; !ok {
+					continue
+				}
+				for _, ev := range st.Events {
+					if !ec2EventScheduled(ev) {
+						continue
+					}
+					verdicts.add(id, &Verdict{
+						Checker: ec2EventsCheckerName,
+						Reason: fmt.Sprintf("scheduled %s not before %s",
+							aws.StringValue(ev.Code), aws.TimeValue(ev.NotBefore).UTC().Format(time.RFC3339)),
+					})
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return verdicts, nil
+}
+
+// ec2EventScheduled returns true if the event is one of the watched ones and is still scheduled,
+// AWS prefixes the description of the completed and the canceled events
+func ec2EventScheduled(ev *ec2.InstanceStatusEvent) bool {
+	desc := aws.StringValue(ev.Description)
+	if strings.HasPrefix(desc, "[Completed]") || strings.HasPrefix(desc, "[Canceled]") {
+		return false
+	}
+	code := aws.StringValue(ev.Code)
+	for _, c := range ec2ScheduledEventCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
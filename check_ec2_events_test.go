@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestEC2EventsChecker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	notBefore := time.Now().UTC().Add(48 * time.Hour)
+	awsMock.MockDescribeInstanceStatusPagesEvents(t, mockEC2Cli, map[string][]*ec2.InstanceStatusEvent{
+		"i-0": {{Code: aws.String(ec2.EventCodeInstanceRetirement), NotBefore: &notBefore}},
+		"i-1": {{Code: aws.String(ec2.EventCodeSystemReboot), Description: aws.String("[Completed] scheduled reboot"), NotBefore: &notBefore}},
+		"i-2": {{Code: aws.String(ec2.EventCodeSystemMaintenance), NotBefore: &notBefore}},
+		"i-3": {
+			{Code: aws.String(ec2.EventCodeInstanceStop), NotBefore: &notBefore},
+			{Code: aws.String(ec2.EventCodeSystemReboot), NotBefore: &notBefore},
+		},
+		// Not on the cluster
+		"i-9": {{Code: aws.String(ec2.EventCodeInstanceRetirement), NotBefore: &notBefore}},
+	})
+
+	c := &EC2EventsChecker{ec2Cli: mockEC2Cli}
+
+	var cis []*ecs.ContainerInstance
+	for _, id := range []string{"i-0", "i-1", "i-2", "i-3", "i-4"} {
+		cis = append(cis, &ecs.ContainerInstance{Ec2InstanceId: aws.String(id), AgentConnected: aws.Bool(true)})
+	}
+
+	vs, err := c.Unhealthy(&ClusterSnapshot{Time: time.Now().UTC(), ContainerInstances: cis})
+	if err != nil {
+		t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+	}
+
+	want := map[string]int{"i-0": 1, "i-3": 2}
+	if len(vs) != len(want) {
+		t.Errorf("Wrong number of unhealthy instances; got: %d, want: %d", len(vs), len(want))
+	}
+	for id, n := range want {
+		if len(vs[id]) != n {
+			t.Errorf("Wrong number of %s verdicts; got: %d, want: %d", id, len(vs[id]), n)
+		}
+	}
+}
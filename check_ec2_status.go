@@ -0,0 +1,77 @@
+package main
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// The EC2 status checks, the filter name and the verdict reason
+var ec2StatusChecks = []struct {
+	filter string
+	reason string
+}{
+	{"system-status.status", "EC2 system status check impaired"},
+	{"instance-status.status", "EC2 instance status check impaired"},
+}
+
+// EC2StatusChecker will flag the instances that fail the EC2 system or instance status checks
+type EC2StatusChecker struct {
+	ec2Cli ec2iface.EC2API
+
+	// The time an instance needs to be impaired before marking it, overrides unhealthy.after
+	after time.Duration
+}
+
+// NewEC2StatusChecker creates an EC2StatusChecker
+func NewEC2StatusChecker(s *session.Session, after time.Duration) *EC2StatusChecker {
+	return &EC2StatusChecker{
+		ec2Cli: ec2.New(s),
+		after:  after,
+	}
+}
+
+// Unhealthy returns the cluster instances with impaired EC2 status checks
+func (c *EC2StatusChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	if len(snapshot.ContainerInstances) == 0 {
+		return verdicts, nil
+	}
+	byID := snapshot.byInstanceID()
+
+	// Ask only for the impaired ones, asking by instance ID fails if any of them doesn't exist
+	for _, check := range ec2StatusChecks {
+		params := &ec2.DescribeInstanceStatusInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String(check.filter),
+					Values: []*string{aws.String(ec2.SummaryStatusImpaired)},
+				},
+			},
+		}
+		reason := check.reason
+		err := c.ec2Cli.DescribeInstanceStatusPages(params,
+			func(page *ec2.DescribeInstanceStatusOutput, lastPage bool) bool {
+				for _, st := range page.InstanceStatuses {
+					id := aws.StringValue(st.InstanceId)
+					if _, ok := byID[id]; !ok {
+						continue
+					}
+					verdicts.add(id, &Verdict{
+						Checker: ec2StatusCheckerName,
+						Reason:  reason,
+						After:   c.after,
+					})
+				}
+				return true
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return verdicts, nil
+}
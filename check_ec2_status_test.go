@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestEC2StatusChecker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// i-9 isn't on the cluster
+	awsMock.MockDescribeInstanceStatusPages(t, mockEC2Cli, map[string]string{
+		"i-0": "system-status.status",
+		"i-1": "instance-status.status",
+		"i-9": "system-status.status",
+	})
+
+	c := &EC2StatusChecker{ec2Cli: mockEC2Cli, after: 3 * time.Minute}
+
+	var cis []*ecs.ContainerInstance
+	for _, id := range []string{"i-0", "i-1", "i-2"} {
+		cis = append(cis, &ecs.ContainerInstance{Ec2InstanceId: aws.String(id), AgentConnected: aws.Bool(true)})
+	}
+
+	vs, err := c.Unhealthy(&ClusterSnapshot{Time: time.Now().UTC(), ContainerInstances: cis})
+	if err != nil {
+		t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+	}
+
+	if len(vs) != 2 {
+		t.Errorf("Wrong number of unhealthy instances; got: %d, want: %d", len(vs), 2)
+	}
+	for _, id := range []string{"i-0", "i-1"} {
+		if len(vs[id]) != 1 {
+			t.Errorf("Wrong number of %s verdicts; got: %d, want: %d", id, len(vs[id]), 1)
+			continue
+		}
+		if vs[id][0].Checker != ec2StatusCheckerName || vs[id][0].After != 3*time.Minute {
+			t.Errorf("Wrong %s verdict; got: %+v", id, vs[id][0])
+		}
+	}
+}
+
+func TestEC2StatusCheckerError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	awsMock.MockDescribeInstanceStatusPagesError(t, mockEC2Cli)
+
+	c := &EC2StatusChecker{ec2Cli: mockEC2Cli, after: 3 * time.Minute}
+	cis := []*ecs.ContainerInstance{{Ec2InstanceId: aws.String("i-0")}}
+	if _, err := c.Unhealthy(&ClusterSnapshot{ContainerInstances: cis}); err == nil {
+		t.Errorf("Unhealthy should give an error, it didn't")
+	}
+}
+
+func TestUnhealthyInstanceMarkAfter(t *testing.T) {
+	tests := []struct {
+		verdicts []*Verdict
+
+		want time.Duration
+	}{
+		{nil, time.Minute},
+		{[]*Verdict{{Checker: agentConnectedCheckerName}}, time.Minute},
+		{[]*Verdict{{Checker: ec2StatusCheckerName, After: 3 * time.Minute}}, 3 * time.Minute},
+		{[]*Verdict{{Checker: ec2StatusCheckerName, After: 30 * time.Second}}, 30 * time.Second},
+		{[]*Verdict{{Checker: agentConnectedCheckerName}, {Checker: ec2StatusCheckerName, After: 3 * time.Minute}}, time.Minute},
+	}
+
+	for _, test := range tests {
+		u := &unhealthyInstance{verdicts: test.verdicts}
+		if got := u.markAfter(time.Minute); got != test.want {
+			t.Errorf("%+v\n- Wrong mark after; got: %s, want: %s", test, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+)
+
+const (
+	// The maximum services of a DescribeServices call
+	describeServicesMax = 10
+
+	elbStateOutOfService = "OutOfService"
+
+	// The interval between the discoveries of the cluster services ELBs
+	elbDiscoveryInterval = 5 * time.Minute
+)
+
+// ELBChecker will flag the instances that are out of service on all the classic ELBs of
+// the cluster services where they are registered
+type ELBChecker struct {
+	ecsCli ecsiface.ECSAPI
+	elbCli elbiface.ELBAPI
+
+	// the name of the cluster
+	clusterName string
+
+	// The time an instance needs to be out of service before marking it, overrides unhealthy.after
+	after time.Duration
+
+	// The ELBs with more than this percent of out of service instances are ignored, a broken
+	// service takes out all the instances behind its ELB and the instances aren't the problem
+	guardPercent int
+
+	// The ELBs of the last discovery, when it was done and the interval between the discoveries
+	lbs               []string
+	discovered        time.Time
+	discoveryInterval time.Duration
+}
+
+// NewELBChecker creates an ELBChecker
+func NewELBChecker(clusterName string, s *session.Session, after time.Duration, guardPercent int) *ELBChecker {
+	return &ELBChecker{
+		ecsCli:            ecs.New(s),
+		elbCli:            elb.New(s),
+		clusterName:       clusterName,
+		after:             after,
+		guardPercent:      guardPercent,
+		discoveryInterval: elbDiscoveryInterval,
+	}
+}
+
+// Unhealthy returns the cluster instances out of service on all their ELBs
+func (c *ELBChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	if len(snapshot.ContainerInstances) == 0 {
+		return verdicts, nil
+	}
+
+	if c.lbs == nil || snapshot.Time.Sub(c.discovered) >= c.discoveryInterval {
+		lbs, err := c.loadBalancers()
+		if err != nil {
+			return nil, err
+		}
+		c.lbs = lbs
+		c.discovered = snapshot.Time
+	}
+
+	// The ELBs where each instance is registered and the ones where it's out of service
+	registered := map[string]int{}
+	outOfService := map[string][]string{}
+	for _, lb := range c.lbs {
+		params := &elb.DescribeInstanceHealthInput{
+			LoadBalancerName: aws.String(lb),
+		}
+		resp, err := c.elbCli.DescribeInstanceHealth(params)
+		if err != nil {
+			return nil, err
+		}
+
+		// Don't blame the instances when most of them are out of service
+		out := 0
+		for _, is := range resp.InstanceStates {
+			if aws.StringValue(is.State) == elbStateOutOfService {
+				out++
+			}
+		}
+		if total := len(resp.InstanceStates); total > 0 && out*100 > c.guardPercent*total {
+			componentLog(c.clusterName, componentChecker).WithFields(logrus.Fields{
+				"elb":            lb,
+				"out_of_service": out,
+				"total":          total,
+			}).Warning("Most of the ELB targets are out of service, ignoring the ELB")
+			continue
+		}
+
+		for _, is := range resp.InstanceStates {
+			id := aws.StringValue(is.InstanceId)
+			registered[id]++
+			if aws.StringValue(is.State) == elbStateOutOfService {
+				outOfService[id] = append(outOfService[id], lb)
+			}
+		}
+	}
+
+	for _, ci := range snapshot.ContainerInstances {
+		id := aws.StringValue(ci.Ec2InstanceId)
+		out := outOfService[id]
+		if len(out) == 0 || len(out) != registered[id] {
+			continue
+		}
+		verdicts.add(id, &Verdict{
+			Checker: elbCheckerName,
+			Reason:  fmt.Sprintf("out of service on all its ELBs: %s", strings.Join(out, ",")),
+			After:   c.after,
+		})
+	}
+
+	return verdicts, nil
+}
+
+// loadBalancers returns the classic ELB names used by the cluster services
+func (c *ELBChecker) loadBalancers() ([]string, error) {
+	lparams := &ecs.ListServicesInput{
+		Cluster: aws.String(c.clusterName),
+	}
+	var arns []*string
+	err := c.ecsCli.ListServicesPages(lparams,
+		func(page *ecs.ListServicesOutput, lastPage bool) bool {
+			arns = append(arns, page.ServiceArns...)
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	lbs := map[string]bool{}
+	for i := 0; i < len(arns); i = i + describeServicesMax {
+		end := i + describeServicesMax
+		if end > len(arns) {
+			end = len(arns)
+		}
+		dparams := &ecs.DescribeServicesInput{
+			Cluster:  aws.String(c.clusterName),
+			Services: arns[i:end],
+		}
+		resp, err := c.ecsCli.DescribeServices(dparams)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range resp.Services {
+			for _, lb := range s.LoadBalancers {
+				if name := aws.StringValue(lb.LoadBalancerName); name != "" {
+					lbs[name] = true
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(lbs))
+	for n := range lbs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestELBChecker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+	mockELBCli := sdk.NewMockELBAPI(ctrl)
+
+	service := func(arn string, lbs ...string) *ecs.Service {
+		s := &ecs.Service{ServiceArn: aws.String(arn)}
+		for _, lb := range lbs {
+			s.LoadBalancers = append(s.LoadBalancers, &ecs.LoadBalancer{LoadBalancerName: aws.String(lb)})
+		}
+		return s
+	}
+	services := []*ecs.Service{
+		service("svc-0", "elb-0"),
+		service("svc-1", "elb-1"),
+		service("svc-2"),
+		service("svc-3", "elb-2"),
+	}
+	listed := 0
+	awsMock.MockListServicesPagesCalls(t, mockECSCli, &listed, services...)
+	awsMock.MockDescribeServices(t, mockECSCli, services...)
+
+	// i-0 healthy, i-1 out on one of them, i-2 out on all, i-3 not registered, i-9 not on the cluster,
+	// i-4 and i-5 out on an ELB with most of its instances out of service
+	awsMock.MockDescribeInstanceHealth(t, mockELBCli, map[string]map[string]string{
+		"elb-0": {"i-0": "InService", "i-1": "InService", "i-2": "OutOfService", "i-9": "OutOfService"},
+		"elb-1": {"i-0": "InService", "i-1": "OutOfService", "i-2": "OutOfService", "i-3": "InService", "i-6": "InService"},
+		"elb-2": {"i-4": "OutOfService", "i-5": "OutOfService", "i-6": "InService"},
+	})
+
+	c := &ELBChecker{
+		ecsCli:      mockECSCli,
+		elbCli:      mockELBCli,
+		clusterName: "test",
+		after:       2 * time.Minute,
+
+		guardPercent:      50,
+		discoveryInterval: time.Hour,
+	}
+
+	var cis []*ecs.ContainerInstance
+	for _, id := range []string{"i-0", "i-1", "i-2", "i-3", "i-4", "i-5", "i-6"} {
+		cis = append(cis, &ecs.ContainerInstance{Ec2InstanceId: aws.String(id), AgentConnected: aws.Bool(true)})
+	}
+
+	now := time.Now().UTC()
+	// The ELBs are discovered again after the discovery interval
+	for _, at := range []time.Time{now, now.Add(time.Minute), now.Add(2 * time.Hour)} {
+		vs, err := c.Unhealthy(&ClusterSnapshot{Time: at, ContainerInstances: cis})
+		if err != nil {
+			t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+		}
+
+		if len(vs) != 1 || len(vs["i-2"]) != 1 {
+			t.Fatalf("Only i-2 should be unhealthy; got: %v", vs)
+		}
+		v := vs["i-2"][0]
+		if v.Checker != elbCheckerName || v.After != 2*time.Minute {
+			t.Errorf("Wrong verdict; got: %+v", v)
+		}
+	}
+
+	if listed != 2 {
+		t.Errorf("Wrong number of service discoveries; got: %d, want: %d", listed, 2)
+	}
+}
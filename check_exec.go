@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// Exec checker failure policies, what to do with an instance when the command fails
+const (
+	// execFailureIgnore considers the instance healthy
+	execFailureIgnore = "ignore"
+	// execFailureUnhealthy considers the instance unhealthy
+	execFailureUnhealthy = "unhealthy"
+	// execFailureError fails the checker
+	execFailureError = "error"
+)
+
+// The exit codes of the command when it doesn't return a JSON verdict
+const (
+	execExitHealthy   = 0
+	execExitUnhealthy = 1
+)
+
+// execInput is the container instance description sent to the command on stdin
+type execInput struct {
+	Cluster              string            `json:"cluster"`
+	InstanceID           string            `json:"instance_id"`
+	ContainerInstanceArn string            `json:"container_instance_arn"`
+	Status               string            `json:"status"`
+	AgentConnected       bool              `json:"agent_connected"`
+	Attributes           map[string]string `json:"attributes"`
+	RunningTasksCount    int64             `json:"running_tasks_count"`
+	PendingTasksCount    int64             `json:"pending_tasks_count"`
+	RegisteredResources  map[string]int64  `json:"registered_resources"`
+	RemainingResources   map[string]int64  `json:"remaining_resources"`
+	Tags                 map[string]string `json:"tags"`
+}
+
+// execOutput is the verdict that the command can return on stdout, it takes precedence over the exit code
+type execOutput struct {
+	Healthy *bool  `json:"healthy"`
+	Reason  string `json:"reason"`
+}
+
+// ExecChecker will run an external command for each container instance, the command decides
+// if the instance is healthy, so site specific probes can be added without changing the watcher
+type ExecChecker struct {
+	ec2Cli ec2iface.EC2API
+
+	// the name of the cluster
+	clusterName string
+
+	// The command and its arguments
+	command []string
+
+	// The maximum time a command can run
+	timeout time.Duration
+
+	// The maximum commands running at the same time
+	concurrency int
+
+	// What to do when the command fails
+	failurePolicy string
+}
+
+// NewExecChecker creates an ExecChecker
+func NewExecChecker(clusterName string, s *session.Session, command []string, timeout time.Duration, concurrency int, failurePolicy string) (*ExecChecker, error) {
+	if len(command) == 0 {
+		return nil, fmt.Errorf("exec checker needs a command")
+	}
+	return &ExecChecker{
+		ec2Cli:        ec2.New(s),
+		clusterName:   clusterName,
+		command:       command,
+		timeout:       timeout,
+		concurrency:   concurrency,
+		failurePolicy: failurePolicy,
+	}, nil
+}
+
+// Unhealthy returns the container instances that the command declared unhealthy
+func (c *ExecChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	log := componentLog(c.clusterName, componentChecker)
+	verdicts := Verdicts{}
+	if len(snapshot.ContainerInstances) == 0 {
+		return verdicts, nil
+	}
+
+	// The EC2 instances for the tags
+	ids := make([]*string, len(snapshot.ContainerInstances))
+	for i, ci := range snapshot.ContainerInstances {
+		ids[i] = ci.Ec2InstanceId
+	}
+	instances, err := describeInstances(c.ec2Cli, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := c.concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	mutex := &sync.Mutex{}
+	var wg sync.WaitGroup
+	var lastErr error
+	for _, ci := range snapshot.ContainerInstances {
+		in := c.input(ci, instances[aws.StringValue(ci.Ec2InstanceId)])
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			v, err := c.run(in)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				log.WithError(err).WithField(logFieldInstanceID, in.InstanceID).Error("Error running exec checker command")
+				switch c.failurePolicy {
+				case execFailureUnhealthy:
+					v = &Verdict{
+						Checker: execCheckerName,
+						Reason:  fmt.Sprintf("command failed: %s", err),
+					}
+				case execFailureError:
+					lastErr = err
+				}
+			}
+			if v != nil {
+				verdicts.add(in.InstanceID, v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return verdicts, nil
+}
+
+// run runs the command with the instance description, it returns a verdict when the
+// instance is unhealthy
+func (c *ExecChecker) run(in *execInput) (*Verdict, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := runCommand(c.command, c.timeout, b)
+	if err != nil {
+		return nil, err
+	}
+	code := res.code
+
+	// A JSON verdict wins over the exit code
+	out := &execOutput{}
+	if err := json.Unmarshal([]byte(res.stdout), out); err == nil && out.Healthy != nil && (code == execExitHealthy || code == execExitUnhealthy) {
+		if *out.Healthy {
+			return nil, nil
+		}
+		return c.verdict(out.Reason), nil
+	}
+
+	switch code {
+	case execExitHealthy:
+		return nil, nil
+	case execExitUnhealthy:
+		reason := strings.TrimSpace(res.stdout)
+		if reason == "" {
+			reason = strings.TrimSpace(res.stderr)
+		}
+		return c.verdict(reason), nil
+	}
+	return nil, fmt.Errorf("command exited with %d: %s", code, strings.TrimSpace(res.stderr))
+}
+
+// verdict returns the unhealthy verdict of the command with the reason
+func (c *ExecChecker) verdict(reason string) *Verdict {
+	if reason == "" {
+		reason = "declared unhealthy by the command"
+	}
+	return &Verdict{
+		Checker: execCheckerName,
+		Reason:  reason,
+	}
+}
+
+// input returns the description of the container instance for the command
+func (c *ExecChecker) input(ci *ecs.ContainerInstance, i *ec2.Instance) *execInput {
+	in := &execInput{
+		Cluster:              c.clusterName,
+		InstanceID:           aws.StringValue(ci.Ec2InstanceId),
+		ContainerInstanceArn: aws.StringValue(ci.ContainerInstanceArn),
+		Status:               aws.StringValue(ci.Status),
+		AgentConnected:       aws.BoolValue(ci.AgentConnected),
+		Attributes:           map[string]string{},
+		RunningTasksCount:    aws.Int64Value(ci.RunningTasksCount),
+		PendingTasksCount:    aws.Int64Value(ci.PendingTasksCount),
+		RegisteredResources:  integerResources(ci.RegisteredResources),
+		RemainingResources:   integerResources(ci.RemainingResources),
+		Tags:                 map[string]string{},
+	}
+	for _, a := range ci.Attributes {
+		in.Attributes[aws.StringValue(a.Name)] = aws.StringValue(a.Value)
+	}
+	if i != nil {
+		for _, t := range i.Tags {
+			in.Tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+	}
+	return in
+}
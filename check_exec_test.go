@@ -0,0 +1,126 @@
+package main
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestExecChecker(t *testing.T) {
+	tests := []struct {
+		script        string
+		timeout       time.Duration
+		failurePolicy string
+
+		wantUnhealthy []string
+		wantReason    string
+		wantError     bool
+	}{
+		// Exit code
+		{`cat >/dev/null; exit 0`, time.Second, execFailureIgnore, nil, "", false},
+		{`case "$(cat)" in *'"role":"web"'*) echo "web probe failed"; exit 1;; esac`, time.Second, execFailureIgnore, []string{"i-1"}, "web probe failed", false},
+		{`case "$(cat)" in *'"running_tasks_count":0'*) exit 1;; esac`, time.Second, execFailureIgnore, []string{"i-0"}, "declared unhealthy by the command", false},
+		// JSON verdict wins over the exit code
+		{`cat >/dev/null; echo '{"healthy":false,"reason":"json reason"}'`, time.Second, execFailureIgnore, []string{"i-0", "i-1"}, "json reason", false},
+		{`cat >/dev/null; echo '{"healthy":true}'; exit 1`, time.Second, execFailureIgnore, nil, "", false},
+		// Failures
+		{`cat >/dev/null; exit 3`, time.Second, execFailureIgnore, nil, "", false},
+		{`cat >/dev/null; exit 3`, time.Second, execFailureUnhealthy, []string{"i-0", "i-1"}, "command failed: command exited with 3: ", false},
+		{`cat >/dev/null; exit 3`, time.Second, execFailureError, nil, "", true},
+		{`sleep 5; true`, 100 * time.Millisecond, execFailureUnhealthy, []string{"i-0", "i-1"}, "command failed: command timed out after 100ms", false},
+	}
+
+	for _, test := range tests {
+		ctrl := gomock.NewController(t)
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		awsMock.MockDescribeInstancesPagesTags(t, mockEC2Cli, map[string]map[string]string{
+			"i-0": {"role": "batch"},
+			"i-1": {"role": "web"},
+		})
+
+		c := &ExecChecker{
+			ec2Cli:        mockEC2Cli,
+			clusterName:   "test",
+			command:       []string{"sh", "-c", test.script},
+			timeout:       test.timeout,
+			concurrency:   2,
+			failurePolicy: test.failurePolicy,
+		}
+
+		snapshot := &ClusterSnapshot{
+			Time: time.Now().UTC(),
+			ContainerInstances: []*ecs.ContainerInstance{
+				{Ec2InstanceId: aws.String("i-0"), RunningTasksCount: aws.Int64(0)},
+				{Ec2InstanceId: aws.String("i-1"), RunningTasksCount: aws.Int64(3)},
+			},
+		}
+
+		vs, err := c.Unhealthy(snapshot)
+		ctrl.Finish()
+		if test.wantError {
+			if err == nil {
+				t.Errorf("%+v\n- Unhealthy should give an error, it didn't", test)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+			continue
+		}
+
+		var got []string
+		for id, v := range vs {
+			got = append(got, id)
+			if v[0].Checker != execCheckerName || v[0].Reason != test.wantReason {
+				t.Errorf("%+v\n- Wrong verdict; got: %+v", test, v[0])
+			}
+		}
+		sort.Strings(got)
+		if len(got) != len(test.wantUnhealthy) {
+			t.Errorf("%+v\n- Wrong unhealthy instances; got: %v, want: %v", test, got, test.wantUnhealthy)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.wantUnhealthy[i] {
+				t.Errorf("%+v\n- Wrong unhealthy instances; got: %v, want: %v", test, got, test.wantUnhealthy)
+			}
+		}
+	}
+}
+
+func TestExecCheckerConcurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	awsMock.MockDescribeInstancesPagesTags(t, mockEC2Cli, map[string]map[string]string{})
+
+	// Each command takes 200ms, 4 instances with 2 at the same time take 2 rounds
+	c := &ExecChecker{
+		ec2Cli:        mockEC2Cli,
+		clusterName:   "test",
+		command:       []string{"sh", "-c", "cat >/dev/null; sleep 0.2"},
+		timeout:       5 * time.Second,
+		concurrency:   2,
+		failurePolicy: execFailureError,
+	}
+
+	var cis []*ecs.ContainerInstance
+	for _, id := range []string{"i-0", "i-1", "i-2", "i-3"} {
+		cis = append(cis, &ecs.ContainerInstance{Ec2InstanceId: aws.String(id)})
+	}
+
+	start := time.Now()
+	if _, err := c.Unhealthy(&ClusterSnapshot{Time: start, ContainerInstances: cis}); err != nil {
+		t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+	}
+	if d := time.Since(start); d < 400*time.Millisecond {
+		t.Errorf("Commands ran with more concurrency than allowed; took: %s", d)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// OrphansChecker will flag the EC2 instances of the cluster that never registered on it, like
+// the ones whose user data failed, the instances of the cluster are the ones on its
+// autoscaling groups or the ones with the cluster tag
+type OrphansChecker struct {
+	ec2Cli ec2iface.EC2API
+	asgCli autoscalingiface.AutoScalingAPI
+
+	// The autoscaling groups of the cluster
+	asgs []string
+
+	// The tag of the cluster instances, key:value form, empty to not use it
+	tag string
+
+	// The time an instance has to register since it was launched
+	grace time.Duration
+}
+
+// NewOrphansChecker creates an OrphansChecker
+func NewOrphansChecker(s *session.Session, asgs []string, tag string, grace time.Duration) *OrphansChecker {
+	return &OrphansChecker{
+		ec2Cli: ec2.New(s),
+		asgCli: autoscaling.New(s),
+		asgs:   asgs,
+		tag:    tag,
+		grace:  grace,
+	}
+}
+
+// Unhealthy returns the running instances of the cluster that aren't registered after the grace period
+func (c *OrphansChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+
+	instances, err := c.clusterInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	registered := snapshot.byInstanceID()
+	for id, i := range instances {
+		if _, ok := registered[id]; ok {
+			continue
+		}
+		d := snapshot.Time.Sub(aws.TimeValue(i.LaunchTime))
+		if d < c.grace {
+			continue
+		}
+		verdicts.add(id, &Verdict{
+			Checker: orphansCheckerName,
+			Reason:  fmt.Sprintf("running for %s without registering on the cluster", d),
+		})
+	}
+	return verdicts, nil
+}
+
+// clusterInstances returns the running instances of the cluster by ID
+func (c *OrphansChecker) clusterInstances() (map[string]*ec2.Instance, error) {
+	instances := map[string]*ec2.Instance{}
+	running := &ec2.Filter{
+		Name:   aws.String("instance-state-name"),
+		Values: []*string{aws.String(ec2.InstanceStateNameRunning)},
+	}
+
+	// The ones of the autoscaling groups
+	ids, err := c.asgInstanceIDs()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(ids); i = i + checkMaxAWSFilterValues {
+		end := i + checkMaxAWSFilterValues
+		if end > len(ids) {
+			end = len(ids)
+		}
+		filters := []*ec2.Filter{
+			running,
+			{Name: aws.String("instance-id"), Values: ids[i:end]},
+		}
+		if err := c.describeInstances(filters, instances); err != nil {
+			return nil, err
+		}
+	}
+
+	// The ones with the cluster tag
+	if c.tag != "" {
+		splTag := strings.SplitN(c.tag, ":", 2)
+		filters := []*ec2.Filter{
+			running,
+			{Name: aws.String(fmt.Sprintf("tag:%s", splTag[0])), Values: []*string{aws.String(splTag[1])}},
+		}
+		if err := c.describeInstances(filters, instances); err != nil {
+			return nil, err
+		}
+	}
+
+	return instances, nil
+}
+
+// asgInstanceIDs returns the in service instance IDs of the autoscaling groups
+func (c *OrphansChecker) asgInstanceIDs() ([]*string, error) {
+	if len(c.asgs) == 0 {
+		return nil, nil
+	}
+
+	var ids []*string
+	params := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(c.asgs),
+	}
+	err := c.asgCli.DescribeAutoScalingGroupsPages(params,
+		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			for _, g := range page.AutoScalingGroups {
+				for _, i := range g.Instances {
+					// The ones on standby or leaving the group aren't expected to be registered
+					if aws.StringValue(i.LifecycleState) == autoscaling.LifecycleStateInService {
+						ids = append(ids, i.InstanceId)
+					}
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// describeInstances adds the instances that match the filters to the instances map
+func (c *OrphansChecker) describeInstances(filters []*ec2.Filter, instances map[string]*ec2.Instance) error {
+	params := &ec2.DescribeInstancesInput{
+		Filters: filters,
+	}
+	return c.ec2Cli.DescribeInstancesPages(params,
+		func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, r := range page.Reservations {
+				for _, i := range r.Instances {
+					instances[aws.StringValue(i.InstanceId)] = i
+				}
+			}
+			return true
+		})
+}
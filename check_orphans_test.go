@@ -0,0 +1,81 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestOrphansChecker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockASGCli := sdk.NewMockAutoScalingAPI(ctrl)
+
+	now := time.Now().UTC()
+	launched := func(id string, ago time.Duration, state string, tag string) *ec2.Instance {
+		l := now.Add(-ago)
+		i := &ec2.Instance{
+			InstanceId: aws.String(id),
+			LaunchTime: &l,
+			State:      &ec2.InstanceState{Name: aws.String(state)},
+		}
+		if tag != "" {
+			i.Tags = []*ec2.Tag{{Key: aws.String("cluster"), Value: aws.String(tag)}}
+		}
+		return i
+	}
+
+	awsMock.MockDescribeAutoScalingGroupsPages(t, mockASGCli, map[string]map[string]string{
+		"asg-0": {
+			"i-0": autoscaling.LifecycleStateInService,
+			"i-1": autoscaling.LifecycleStateInService,
+			"i-2": autoscaling.LifecycleStateStandby,
+			"i-8": autoscaling.LifecycleStateInService,
+		},
+	})
+	// i-0 registered, i-1 orphan, i-2 on standby, i-3 orphan by tag, i-4 in the grace period,
+	// i-5 stopped, i-6 of another cluster, i-7 without group nor tag, i-8 terminated
+	awsMock.MockDescribeInstancesPagesFiltered(t, mockEC2Cli,
+		launched("i-0", time.Hour, ec2.InstanceStateNameRunning, ""),
+		launched("i-1", time.Hour, ec2.InstanceStateNameRunning, ""),
+		launched("i-2", time.Hour, ec2.InstanceStateNameRunning, ""),
+		launched("i-3", time.Hour, ec2.InstanceStateNameRunning, "test"),
+		launched("i-4", time.Minute, ec2.InstanceStateNameRunning, "test"),
+		launched("i-5", time.Hour, ec2.InstanceStateNameStopped, "test"),
+		launched("i-6", time.Hour, ec2.InstanceStateNameRunning, "other"),
+		launched("i-7", time.Hour, ec2.InstanceStateNameRunning, ""),
+		launched("i-8", time.Hour, ec2.InstanceStateNameTerminated, ""),
+	)
+
+	c := &OrphansChecker{
+		ec2Cli: mockEC2Cli,
+		asgCli: mockASGCli,
+		asgs:   []string{"asg-0"},
+		tag:    "cluster:test",
+		grace:  15 * time.Minute,
+	}
+
+	cis := []*ecs.ContainerInstance{{Ec2InstanceId: aws.String("i-0")}}
+	vs, err := c.Unhealthy(&ClusterSnapshot{Time: now, ContainerInstances: cis})
+	if err != nil {
+		t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+	}
+
+	if len(vs) != 2 {
+		t.Errorf("Wrong number of orphan instances; got: %d, want: %d", len(vs), 2)
+	}
+	for _, id := range []string{"i-1", "i-3"} {
+		if len(vs[id]) != 1 || vs[id][0].Checker != orphansCheckerName {
+			t.Errorf("%s should be an orphan; got: %v", id, vs[id])
+		}
+	}
+}
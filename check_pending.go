@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// pendingState is the pending tasks state of a container instance between checks
+type pendingState struct {
+	// Since when the instance has pending tasks without running more
+	since time.Time
+	// The running tasks when the pending ones were seen
+	running int64
+}
+
+// PendingTasksChecker will flag the instances that have tasks pending for too long while the
+// running tasks don't grow, like when the images can't be pulled or docker is broken
+type PendingTasksChecker struct {
+	// The time the tasks can be pending without running more
+	after time.Duration
+
+	// The pending tasks state of the instances
+	states map[string]pendingState
+}
+
+// NewPendingTasksChecker creates a PendingTasksChecker
+func NewPendingTasksChecker(after time.Duration) *PendingTasksChecker {
+	return &PendingTasksChecker{
+		after:  after,
+		states: map[string]pendingState{},
+	}
+}
+
+// Unhealthy returns the instances starving their pending tasks, they are declared since the
+// pending tasks are seen and marked when they starve for the pending time, running more tasks
+// declares them healthy again
+func (c *PendingTasksChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+
+	// The state of the instances that aren't pending or on the cluster anymore is dropped
+	states := map[string]pendingState{}
+	for _, ci := range snapshot.ContainerInstances {
+		id := aws.StringValue(ci.Ec2InstanceId)
+		pending := aws.Int64Value(ci.PendingTasksCount)
+		running := aws.Int64Value(ci.RunningTasksCount)
+		if pending == 0 {
+			continue
+		}
+
+		st, ok := c.states[id]
+		switch {
+		case !ok:
+			st = pendingState{since: snapshot.Time, running: running}
+		case running > st.running:
+			// The instance is running more tasks, it's making progress
+			states[id] = pendingState{since: snapshot.Time, running: running}
+			continue
+		case running < st.running:
+			// Stopped tasks aren't progress, but the new baseline lets see the next started ones
+			st.running = running
+		}
+		states[id] = st
+
+		verdicts.add(id, &Verdict{
+			Checker: pendingTasksCheckerName,
+			Reason:  fmt.Sprintf("%d tasks pending for %s without running more", pending, snapshot.Time.Sub(st.since)),
+			After:   c.after,
+		})
+	}
+	c.states = states
+
+	return verdicts, nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestPendingTasksChecker(t *testing.T) {
+	c := NewPendingTasksChecker(5 * time.Minute)
+	now := time.Now().UTC()
+
+	tests := []struct {
+		at      time.Duration
+		pending int64
+		running int64
+
+		wantVerdicts int
+	}{
+		{0, 0, 2, 0},
+		{1 * time.Minute, 2, 2, 1},
+		{4 * time.Minute, 2, 2, 1},
+		// A task stopped, still starving
+		{7 * time.Minute, 3, 1, 1},
+		// Running more, progress
+		{8 * time.Minute, 2, 2, 0},
+		{12 * time.Minute, 2, 2, 1},
+		// Nothing pending
+		{14 * time.Minute, 0, 4, 0},
+		{15 * time.Minute, 1, 4, 1},
+	}
+
+	for _, test := range tests {
+		ci := &ecs.ContainerInstance{
+			Ec2InstanceId:     aws.String("i-0"),
+			AgentConnected:    aws.Bool(true),
+			PendingTasksCount: aws.Int64(test.pending),
+			RunningTasksCount: aws.Int64(test.running),
+		}
+		vs, err := c.Unhealthy(&ClusterSnapshot{Time: now.Add(test.at), ContainerInstances: []*ecs.ContainerInstance{ci}})
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+		if len(vs["i-0"]) != test.wantVerdicts {
+			t.Errorf("%+v\n- Wrong number of verdicts; got: %d, want: %d", test, len(vs["i-0"]), test.wantVerdicts)
+		}
+		for _, v := range vs["i-0"] {
+			if v.After != c.after {
+				t.Errorf("%+v\n- Verdicts should be marked after the pending time; got: %s, want: %s", test, v.After, c.after)
+			}
+		}
+	}
+}
+
+func TestPendingTasksCheckerDropsGone(t *testing.T) {
+	c := NewPendingTasksChecker(time.Minute)
+	ci := &ecs.ContainerInstance{
+		Ec2InstanceId:     aws.String("i-0"),
+		PendingTasksCount: aws.Int64(1),
+		RunningTasksCount: aws.Int64(0),
+	}
+	c.Unhealthy(&ClusterSnapshot{Time: time.Now().UTC(), ContainerInstances: []*ecs.ContainerInstance{ci}})
+	c.Unhealthy(&ClusterSnapshot{Time: time.Now().UTC()})
+
+	if len(c.states) != 0 {
+		t.Errorf("The state of the gone instances should be dropped; got: %d", len(c.states))
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// Checker names
+const (
+	agentConnectedCheckerName = "agent"
+	agentVersionCheckerName   = "outdated-agent"
+	statusCheckerName         = "status"
+	ec2StatusCheckerName      = "ec2-status"
+	ec2EventsCheckerName      = "ec2-events"
+	pendingTasksCheckerName   = "pending-tasks"
+	taskFailuresCheckerName   = "task-failures"
+	resourcesCheckerName      = "resources"
+	attributesCheckerName     = "attributes"
+	orphansCheckerName        = "orphans"
+	elbCheckerName            = "elb"
+	execCheckerName           = "exec"
+)
+
+// checkerFactory creates an instance checker from the configuration
+type checkerFactory func(cfg Config, s *session.Session) (InstanceChecker, error)
+
+// checkerRegistry has the available instance checkers by name
+var checkerRegistry = map[string]checkerFactory{
+	agentConnectedCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return &AgentConnectedChecker{}, nil
+	},
+	agentVersionCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewAgentVersionChecker(cfg.agentMinVersion, cfg.dockerMinVersion, cfg.agentStagingAfter), nil
+	},
+	statusCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewStatusChecker(s, cfg.statusAfter), nil
+	},
+	ec2StatusCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewEC2StatusChecker(s, cfg.ec2StatusAfter), nil
+	},
+	ec2EventsCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewEC2EventsChecker(s), nil
+	},
+	pendingTasksCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewPendingTasksChecker(cfg.pendingAfter), nil
+	},
+	taskFailuresCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewTaskFailuresChecker(cfg.clusterName, s, cfg.taskFailuresMax, cfg.taskFailuresWindow, cfg.taskFailuresReasons), nil
+	},
+	resourcesCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewResourcesChecker(cfg.resourcesMemoryRatio), nil
+	},
+	attributesCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		if len(cfg.attributesRequired) == 0 {
+			return nil, fmt.Errorf("the required attributes are missing")
+		}
+		return NewAttributesChecker(cfg.attributesRequired, cfg.attributesGroup), nil
+	},
+	orphansCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		if len(cfg.orphansASGs) == 0 && cfg.orphansTag == "" {
+			return nil, fmt.Errorf("the autoscaling groups or the tag of the cluster instances are missing")
+		}
+		return NewOrphansChecker(s, cfg.orphansASGs, cfg.orphansTag, cfg.orphansGrace), nil
+	},
+	elbCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewELBChecker(cfg.clusterName, s, cfg.elbAfter, cfg.elbGuardPercent), nil
+	},
+	execCheckerName: func(cfg Config, s *session.Session) (InstanceChecker, error) {
+		return NewExecChecker(cfg.clusterName, s, strings.Fields(cfg.execCommand), cfg.execTimeout, cfg.execConcurrency, cfg.execFailurePolicy)
+	},
+}
+
+// checkerNames returns the names of the registered checkers
+func checkerNames() []string {
+	names := make([]string, 0, len(checkerRegistry))
+	for n := range checkerRegistry {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newInstanceChecker creates the configured checkers, when more than one are selected
+// they are combined with a composite checker
+func newInstanceChecker(cfg Config, s *session.Session) (InstanceChecker, error) {
+	checkers := make([]InstanceChecker, len(cfg.checkers))
+	for i, name := range cfg.checkers {
+		f, ok := checkerRegistry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown checker: %s", name)
+		}
+		c, err := f(cfg, s)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s checker: %s", name, err)
+		}
+		checkers[i] = c
+	}
+
+	if len(checkers) == 1 {
+		return checkers[0], nil
+	}
+	return NewCompositeChecker(cfg.clusterName, cfg.checkersMode, checkers...), nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestNewInstanceChecker(t *testing.T) {
+	tests := []struct {
+		checkers []string
+
+		wantComposite bool
+		wantError     bool
+	}{
+		{[]string{agentConnectedCheckerName}, false, false},
+		{[]string{agentConnectedCheckerName, agentConnectedCheckerName}, true, false},
+		{[]string{"unknown"}, false, true},
+		{[]string{attributesCheckerName}, false, true},
+		{[]string{orphansCheckerName}, false, true},
+		{[]string{execCheckerName}, false, true},
+	}
+
+	for _, test := range tests {
+		cfg := Config{
+			clusterName:  "test",
+			checkers:     test.checkers,
+			checkersMode: checkModeAny,
+		}
+		c, err := newInstanceChecker(cfg, nil)
+		if test.wantError {
+			if err == nil {
+				t.Errorf("%+v\n- Should give an error, it didn't", test)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v\n- Shouldn't give an error: %s", test, err)
+		}
+
+		if _, ok := c.(*CompositeChecker); ok != test.wantComposite {
+			t.Errorf("%+v\n- Wrong checker type; got: %T", test, c)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	resourceCPU    = "CPU"
+	resourceMemory = "MEMORY"
+
+	// The attribute with the EC2 instance type of the container instance
+	instanceTypeAttribute = "ecs.instance-type"
+
+	// The CPU units of a vCPU
+	cpuUnitsPerVCPU = 1024
+)
+
+// instanceTypeResources are the vCPUs and the memory (MiB) of an EC2 instance type
+type instanceTypeResources struct {
+	vcpus  int64
+	memory int64
+}
+
+// instanceTypes has the resources of the instance types, the unknown ones aren't checked
+var instanceTypes = map[string]instanceTypeResources{
+	"t2.nano":     {1, 512},
+	"t2.micro":    {1, 1024},
+	"t2.small":    {1, 2048},
+	"t2.medium":   {2, 4096},
+	"t2.large":    {2, 8192},
+	"t2.xlarge":   {4, 16384},
+	"t2.2xlarge":  {8, 32768},
+	"m3.medium":   {1, 3840},
+	"m3.large":    {2, 7680},
+	"m3.xlarge":   {4, 15360},
+	"m3.2xlarge":  {8, 30720},
+	"m4.large":    {2, 8192},
+	"m4.xlarge":   {4, 16384},
+	"m4.2xlarge":  {8, 32768},
+	"m4.4xlarge":  {16, 65536},
+	"m4.10xlarge": {40, 163840},
+	"m4.16xlarge": {64, 262144},
+	"c3.large":    {2, 3840},
+	"c3.xlarge":   {4, 7680},
+	"c3.2xlarge":  {8, 15360},
+	"c3.4xlarge":  {16, 30720},
+	"c3.8xlarge":  {32, 61440},
+	"c4.large":    {2, 3840},
+	"c4.xlarge":   {4, 7680},
+	"c4.2xlarge":  {8, 15360},
+	"c4.4xlarge":  {16, 30720},
+	"c4.8xlarge":  {36, 61440},
+	"r3.large":    {2, 15616},
+	"r3.xlarge":   {4, 31232},
+	"r3.2xlarge":  {8, 62464},
+	"r3.4xlarge":  {16, 124928},
+	"r3.8xlarge":  {32, 249856},
+	"r4.large":    {2, 15616},
+	"r4.xlarge":   {4, 31232},
+	"r4.2xlarge":  {8, 62464},
+	"r4.4xlarge":  {16, 124928},
+	"r4.8xlarge":  {32, 249856},
+	"r4.16xlarge": {64, 499712},
+}
+
+// ResourcesChecker will flag the instances whose resource accounting is inconsistent, like
+// reservations leaked by the agent or registered resources that don't match the instance type
+type ResourcesChecker struct {
+	// The minimum ratio of the instance type memory that needs to be registered
+	memoryRatio float64
+}
+
+// NewResourcesChecker creates a ResourcesChecker
+func NewResourcesChecker(memoryRatio float64) *ResourcesChecker {
+	return &ResourcesChecker{
+		memoryRatio: memoryRatio,
+	}
+}
+
+// Unhealthy returns the instances with inconsistent resources
+func (c *ResourcesChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+	for _, ci := range snapshot.ContainerInstances {
+		id := aws.StringValue(ci.Ec2InstanceId)
+		for _, r := range c.mismatches(ci) {
+			verdicts.add(id, &Verdict{
+				Checker: resourcesCheckerName,
+				Reason:  r,
+			})
+		}
+	}
+	return verdicts, nil
+}
+
+// mismatches returns the resource inconsistencies of the container instance
+func (c *ResourcesChecker) mismatches(ci *ecs.ContainerInstance) []string {
+	var res []string
+	registered := integerResources(ci.RegisteredResources)
+	remaining := integerResources(ci.RemainingResources)
+	idle := aws.Int64Value(ci.RunningTasksCount) == 0 && aws.Int64Value(ci.PendingTasksCount) == 0
+
+	for _, name := range []string{resourceCPU, resourceMemory} {
+		reg, okReg := registered[name]
+		rem, okRem := remaining[name]
+		if !okReg || !okRem {
+			continue
+		}
+		switch {
+		case rem > reg:
+			res = append(res, fmt.Sprintf("remaining %s %d greater than registered %d", name, rem, reg))
+		case idle && rem < reg:
+			res = append(res, fmt.Sprintf("%d %s reserved without tasks", reg-rem, name))
+		}
+	}
+
+	// Registered resources of the instance type
+	t, _ := instanceAttribute(ci, instanceTypeAttribute)
+	it, ok := instanceTypes[t]
+	if !ok {
+		return res
+	}
+	if cpu, ok := registered[resourceCPU]; ok && cpu != it.vcpus*cpuUnitsPerVCPU {
+		res = append(res, fmt.Sprintf("registered %s %d doesn't match the instance type %d", resourceCPU, cpu, it.vcpus*cpuUnitsPerVCPU))
+	}
+	if mem, ok := registered[resourceMemory]; ok && (mem > it.memory || float64(mem) < float64(it.memory)*c.memoryRatio) {
+		res = append(res, fmt.Sprintf("registered %s %d doesn't match the instance type %d", resourceMemory, mem, it.memory))
+	}
+	return res
+}
+
+// integerResources returns the integer resources by name
+func integerResources(rs []*ecs.Resource) map[string]int64 {
+	res := map[string]int64{}
+	for _, r := range rs {
+		if r.IntegerValue == nil {
+			continue
+		}
+		res[aws.StringValue(r.Name)] = aws.Int64Value(r.IntegerValue)
+	}
+	return res
+}
+
+// instanceAttribute returns the value of the container instance attribute
+func instanceAttribute(ci *ecs.ContainerInstance, name string) (string, bool) {
+	for _, a := range ci.Attributes {
+		if aws.StringValue(a.Name) == name {
+			return aws.StringValue(a.Value), true
+		}
+	}
+	return "", false
+}
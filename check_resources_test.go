@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestResourcesChecker(t *testing.T) {
+	resources := func(cpu, mem int64) []*ecs.Resource {
+		return []*ecs.Resource{
+			{Name: aws.String(resourceCPU), Type: aws.String("INTEGER"), IntegerValue: aws.Int64(cpu)},
+			{Name: aws.String(resourceMemory), Type: aws.String("INTEGER"), IntegerValue: aws.Int64(mem)},
+			{Name: aws.String("PORTS"), Type: aws.String("STRINGSET")},
+		}
+	}
+
+	tests := []struct {
+		instanceType  string
+		registeredCPU int64
+		registeredMem int64
+		remainingCPU  int64
+		remainingMem  int64
+		running       int64
+		pending       int64
+
+		wantVerdicts int
+	}{
+		// Healthy idle and busy
+		{"m4.large", 2048, 7986, 2048, 7986, 0, 0, 0},
+		{"m4.large", 2048, 7986, 1024, 0, 3, 0, 0},
+		// Unknown instance type isn't checked
+		{"z9.large", 1, 1, 1, 1, 0, 0, 0},
+		{"", 2048, 7986, 2048, 7986, 0, 0, 0},
+		// Leaked reservations
+		{"m4.large", 2048, 7986, 2048, 0, 0, 0, 1},
+		{"m4.large", 2048, 7986, 1024, 0, 0, 0, 2},
+		// Pending tasks aren't a leak
+		{"m4.large", 2048, 7986, 2048, 0, 0, 1, 0},
+		// Remaining greater than registered
+		{"m4.large", 2048, 7986, 4096, 7986, 0, 0, 1},
+		// Registered doesn't match the instance type
+		{"m4.large", 1024, 7986, 1024, 7986, 0, 0, 1},
+		{"m4.large", 2048, 2048, 2048, 2048, 0, 0, 1},
+		{"m4.large", 2048, 9000, 2048, 9000, 0, 0, 1},
+	}
+
+	for _, test := range tests {
+		ci := &ecs.ContainerInstance{
+			Ec2InstanceId:       aws.String("i-0"),
+			RegisteredResources: resources(test.registeredCPU, test.registeredMem),
+			RemainingResources:  resources(test.remainingCPU, test.remainingMem),
+			RunningTasksCount:   aws.Int64(test.running),
+			PendingTasksCount:   aws.Int64(test.pending),
+		}
+		if test.instanceType != "" {
+			ci.Attributes = []*ecs.Attribute{{Name: aws.String(instanceTypeAttribute), Value: aws.String(test.instanceType)}}
+		}
+
+		c := NewResourcesChecker(0.8)
+		vs, err := c.Unhealthy(&ClusterSnapshot{ContainerInstances: []*ecs.ContainerInstance{ci}})
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+		if len(vs["i-0"]) != test.wantVerdicts {
+			t.Errorf("%+v\n- Wrong number of verdicts; got: %d, want: %d", test, len(vs["i-0"]), test.wantVerdicts)
+		}
+	}
+}
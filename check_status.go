@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	// The maximum values of a DescribeInstances filter
+	checkMaxAWSFilterValues = 200
+
+	containerInstanceStatusActive = "ACTIVE"
+)
+
+// StatusChecker will flag the container instances stuck on a non active status and the
+// registered instances with a connected agent whose EC2 instance isn't running anymore, the
+// latter only need to be deregistered from the cluster. The DRAINING status is set on purpose
+// so it isn't stuck
+type StatusChecker struct {
+	ec2Cli ec2iface.EC2API
+
+	// The time a container instance can be on a non active status
+	stuckAfter time.Duration
+}
+
+// NewStatusChecker creates a StatusChecker
+func NewStatusChecker(s *session.Session, stuckAfter time.Duration) *StatusChecker {
+	return &StatusChecker{
+		ec2Cli:     ec2.New(s),
+		stuckAfter: stuckAfter,
+	}
+}
+
+// Unhealthy returns the stuck and the stale container instances, the stuck ones are declared
+// since they are seen on a non active status and marked when they are on it for the stuck time
+func (c *StatusChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+
+	// Stuck ones
+	var connected []*string
+	for _, ci := range snapshot.ContainerInstances {
+		status := aws.StringValue(ci.Status)
+		if status == containerInstanceStatusActive || status == ecs.ContainerInstanceStatusDraining {
+			if aws.BoolValue(ci.AgentConnected) {
+				connected = append(connected, ci.Ec2InstanceId)
+			}
+			continue
+		}
+
+		verdicts.add(aws.StringValue(ci.Ec2InstanceId), &Verdict{
+			Checker: statusCheckerName,
+			Reason:  fmt.Sprintf("container instance %s", status),
+			After:   c.stuckAfter,
+		})
+	}
+
+	// Stale ones
+	states, err := describeInstanceStates(c.ec2Cli, connected)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range connected {
+		state, ok := states[aws.StringValue(id)]
+		if !ok {
+			state = "missing"
+		}
+		switch state {
+		case ec2.InstanceStateNameRunning, ec2.InstanceStateNamePending:
+			continue
+		}
+		verdicts.add(aws.StringValue(id), &Verdict{
+			Checker:    statusCheckerName,
+			Reason:     fmt.Sprintf("registered with agent connected but EC2 instance %s", state),
+			Deregister: true,
+		})
+	}
+
+	return verdicts, nil
+}
+
+// describeInstanceStates returns the EC2 state of the instances, the missing instances aren't on the result
+func describeInstanceStates(cli ec2iface.EC2API, ids []*string) (map[string]string, error) {
+	instances, err := describeInstances(cli, ids)
+	if err != nil {
+		return nil, err
+	}
+	states := map[string]string{}
+	for id, i := range instances {
+		if i.State != nil {
+			states[id] = aws.StringValue(i.State.Name)
+		}
+	}
+	return states, nil
+}
+
+// describeInstances returns the EC2 instances by ID, the missing instances aren't on the result
+func describeInstances(cli ec2iface.EC2API, ids []*string) (map[string]*ec2.Instance, error) {
+	instances := map[string]*ec2.Instance{}
+	for i := 0; i < len(ids); i = i + checkMaxAWSFilterValues {
+		end := i + checkMaxAWSFilterValues
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		// Filter instead of ids, the ids of the instances that don't exist make the call fail
+		params := &ec2.DescribeInstancesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("instance-id"),
+					Values: ids[i:end],
+				},
+			},
+		}
+		err := cli.DescribeInstancesPages(params,
+			func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+				for _, r := range page.Reservations {
+					for _, i := range r.Instances {
+						instances[aws.StringValue(i.InstanceId)] = i
+					}
+				}
+				return true
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return instances, nil
+}
+
+// deregisterOnly returns true if all the verdicts only require to deregister the instance
+func deregisterOnly(verdicts []*Verdict) bool {
+	if len(verdicts) == 0 {
+		return false
+	}
+	for _, v := range verdicts {
+		if !v.Deregister {
+			return false
+		}
+	}
+	return true
+}
+
+// containerInstanceArn returns the ARN of the container instance
+func containerInstanceArn(ci *ecs.ContainerInstance) *string {
+	if ci == nil {
+		return nil
+	}
+	return ci.ContainerInstanceArn
+}
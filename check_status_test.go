@@ -0,0 +1,103 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestStatusCheckerStuck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli)
+
+	c := &StatusChecker{
+		ec2Cli:     mockEC2Cli,
+		stuckAfter: 10 * time.Minute,
+	}
+
+	tests := []struct {
+		status string
+
+		wantVerdicts int
+	}{
+		{"REGISTERING", 1},
+		{"DEREGISTERING", 1},
+		{ecs.ContainerInstanceStatusDraining, 0},
+		{containerInstanceStatusActive, 0},
+	}
+
+	for _, test := range tests {
+		ci := &ecs.ContainerInstance{
+			Ec2InstanceId: aws.String("i-0"),
+			Status:        aws.String(test.status),
+		}
+		vs, err := c.Unhealthy(&ClusterSnapshot{Time: time.Now().UTC(), ContainerInstances: []*ecs.ContainerInstance{ci}})
+		if err != nil {
+			t.Errorf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+		if len(vs["i-0"]) != test.wantVerdicts {
+			t.Errorf("%+v\n- Wrong number of verdicts; got: %d, want: %d", test, len(vs["i-0"]), test.wantVerdicts)
+		}
+		for _, v := range vs["i-0"] {
+			if v.Deregister {
+				t.Errorf("%+v\n- Stuck verdicts shouldn't deregister", test)
+			}
+			if v.After != c.stuckAfter {
+				t.Errorf("%+v\n- Stuck verdicts should be marked after the stuck time; got: %s, want: %s", test, v.After, c.stuckAfter)
+			}
+		}
+	}
+}
+
+func TestStatusCheckerStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// i-3 is missing
+	awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli,
+		&ec2.Instance{InstanceId: aws.String("i-0"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)}},
+		&ec2.Instance{InstanceId: aws.String("i-1"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameStopped)}},
+		&ec2.Instance{InstanceId: aws.String("i-2"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameTerminated)}},
+	)
+
+	c := &StatusChecker{
+		ec2Cli:     mockEC2Cli,
+		stuckAfter: 10 * time.Minute,
+	}
+
+	var cis []*ecs.ContainerInstance
+	for _, id := range []string{"i-0", "i-1", "i-2", "i-3"} {
+		cis = append(cis, &ecs.ContainerInstance{
+			Ec2InstanceId:  aws.String(id),
+			Status:         aws.String(containerInstanceStatusActive),
+			AgentConnected: aws.Bool(true),
+		})
+	}
+
+	vs, err := c.Unhealthy(&ClusterSnapshot{Time: time.Now().UTC(), ContainerInstances: cis})
+	if err != nil {
+		t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+	}
+
+	if len(vs) != 3 {
+		t.Errorf("Wrong number of unhealthy instances; got: %d, want: %d", len(vs), 3)
+	}
+	if _, ok := vs["i-0"]; ok {
+		t.Errorf("Running instance shouldn't be unhealthy")
+	}
+	for id, ivs := range vs {
+		if !deregisterOnly(ivs) {
+			t.Errorf("Stale instance %s verdicts should deregister", id)
+		}
+	}
+}
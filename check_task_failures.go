@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+const (
+	// The maximum tasks of a DescribeTasks call
+	describeTasksMax = 100
+
+	// The interval between the listings of the stopped tasks, the checks in between use the
+	// tasks of the last listing
+	taskFailuresRefreshInterval = time.Minute
+)
+
+// TaskFailuresChecker will flag the instances where the tasks fail to launch repeatedly, the
+// stop reasons of the recently stopped tasks are grouped by instance
+type TaskFailuresChecker struct {
+	ecsCli ecsiface.ECSAPI
+
+	// the name of the cluster
+	clusterName string
+
+	// The failed tasks allowed on an instance in the window
+	max int
+
+	// The window where the failed tasks are counted
+	window time.Duration
+
+	// The stop reasons that are failures, matched as substrings
+	reasons []string
+
+	// The stopped tasks already described by ARN, nil for the ones that aren't failures on
+	// the window so they aren't described again
+	tasks map[string]*ecs.Task
+
+	// When the stopped tasks were listed the last time and the interval between the listings
+	refreshed       time.Time
+	refreshInterval time.Duration
+}
+
+// NewTaskFailuresChecker creates a TaskFailuresChecker
+func NewTaskFailuresChecker(clusterName string, s *session.Session, max int, window time.Duration, reasons []string) *TaskFailuresChecker {
+	return &TaskFailuresChecker{
+		ecsCli:      ecs.New(s),
+		clusterName: clusterName,
+		max:         max,
+		window:      window,
+		reasons:     reasons,
+
+		refreshInterval: taskFailuresRefreshInterval,
+	}
+}
+
+// Unhealthy returns the instances with more failed tasks than the allowed ones in the window
+func (c *TaskFailuresChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	verdicts := Verdicts{}
+
+	tasks, err := c.stoppedTasks(snapshot.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	byArn := map[string]string{}
+	for _, ci := range snapshot.ContainerInstances {
+		byArn[aws.StringValue(ci.ContainerInstanceArn)] = aws.StringValue(ci.Ec2InstanceId)
+	}
+
+	// The failures of each instance by reason
+	failures := map[string]map[string]int{}
+	since := snapshot.Time.Add(-c.window)
+	for _, t := range tasks {
+		id, ok := byArn[aws.StringValue(t.ContainerInstanceArn)]
+		if !ok {
+			continue
+		}
+		if t.StoppedAt == nil || t.StoppedAt.Before(since) {
+			continue
+		}
+		reason, ok := c.failureReason(t)
+		if !ok {
+			continue
+		}
+		if _, ok := failures[id]; !ok {
+			failures[id] = map[string]int{}
+		}
+		failures[id][reason]++
+	}
+
+	for id, rs := range failures {
+		total := 0
+		var reasons []string
+		for r, n := range rs {
+			total += n
+			reasons = append(reasons, fmt.Sprintf("%s x%d", r, n))
+		}
+		if total <= c.max {
+			continue
+		}
+		sort.Strings(reasons)
+		verdicts.add(id, &Verdict{
+			Checker: taskFailuresCheckerName,
+			Reason:  fmt.Sprintf("%d tasks failed in %s: %s", total, c.window, strings.Join(reasons, ", ")),
+		})
+	}
+
+	return verdicts, nil
+}
+
+// stoppedTasks returns the failed tasks of the cluster stopped on the window, ECS keeps the
+// stopped tasks for a while so only the ones that weren't seen before are described
+func (c *TaskFailuresChecker) stoppedTasks(now time.Time) ([]*ecs.Task, error) {
+	if c.tasks == nil || now.Sub(c.refreshed) >= c.refreshInterval {
+		if err := c.refresh(now); err != nil {
+			return nil, err
+		}
+	}
+
+	var tasks []*ecs.Task
+	for _, t := range c.tasks {
+		if t != nil {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks, nil
+}
+
+// refresh will list the stopped tasks of the cluster and describe the new ones, the tasks that
+// aren't listed anymore are forgotten
+func (c *TaskFailuresChecker) refresh(now time.Time) error {
+	lparams := &ecs.ListTasksInput{
+		Cluster:       aws.String(c.clusterName),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+	}
+	tasks := map[string]*ecs.Task{}
+	var unseen []*string
+	err := c.ecsCli.ListTasksPages(lparams,
+		func(page *ecs.ListTasksOutput, lastPage bool) bool {
+			for _, arn := range page.TaskArns {
+				t, ok := c.tasks[aws.StringValue(arn)]
+				if !ok {
+					unseen = append(unseen, arn)
+				}
+				tasks[aws.StringValue(arn)] = t
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	since := now.Add(-c.window)
+	for i := 0; i < len(unseen); i = i + describeTasksMax {
+		end := i + describeTasksMax
+		if end > len(unseen) {
+			end = len(unseen)
+		}
+		dparams := &ecs.DescribeTasksInput{
+			Cluster: aws.String(c.clusterName),
+			Tasks:   unseen[i:end],
+		}
+		resp, err := c.ecsCli.DescribeTasks(dparams)
+		if err != nil {
+			return err
+		}
+		for _, t := range resp.Tasks {
+			arn := aws.StringValue(t.TaskArn)
+			// Only the failures that can be on the window are kept
+			if _, ok := c.failureReason(t); !ok || t.StoppedAt == nil || t.StoppedAt.Before(since) {
+				tasks[arn] = nil
+				continue
+			}
+			tasks[arn] = t
+		}
+	}
+
+	c.tasks = tasks
+	c.refreshed = now
+	return nil
+}
+
+// failureReason returns the failure reason that stopped the task, the reasons of the
+// containers are checked too
+func (c *TaskFailuresChecker) failureReason(t *ecs.Task) (string, bool) {
+	stopReasons := []string{aws.StringValue(t.StoppedReason)}
+	for _, ct := range t.Containers {
+		stopReasons = append(stopReasons, aws.StringValue(ct.Reason))
+	}
+
+	for _, sr := range stopReasons {
+		for _, r := range c.reasons {
+			if strings.Contains(sr, r) {
+				return r, true
+			}
+		}
+	}
+	return "", false
+}
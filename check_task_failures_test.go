@@ -0,0 +1,150 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestTaskFailuresChecker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	now := time.Now().UTC()
+	recent := now.Add(-time.Minute)
+	old := now.Add(-time.Hour)
+	task := func(arn, ci, reason, containerReason string, stoppedAt time.Time) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:              aws.String(arn),
+			ContainerInstanceArn: aws.String(ci),
+			StoppedReason:        aws.String(reason),
+			StoppedAt:            &stoppedAt,
+			Containers:           []*ecs.Container{{Reason: aws.String(containerReason)}},
+		}
+	}
+
+	// Stopped tasks are listed for the whole cluster
+	tasks := map[string][]*ecs.Task{
+		"": {
+			// arn-0 fails repeatedly
+			task("t-0", "arn-0", "", "CannotPullContainerError: image not found", recent),
+			task("t-1", "arn-0", "", "CannotPullContainerError: image not found", recent),
+			task("t-2", "arn-0", "", "CannotStartContainerError: no space left on device", recent),
+			// arn-1 has old failures and regular stops
+			task("t-3", "arn-1", "", "CannotPullContainerError: image not found", old),
+			task("t-4", "arn-1", "", "CannotPullContainerError: image not found", old),
+			task("t-5", "arn-1", "Essential container in task exited", "", recent),
+			task("t-6", "arn-1", "Task stopped by user", "", recent),
+			task("t-7", "arn-1", "", "CannotStartContainerError: oops", recent),
+			// arn-9 isn't on the cluster
+			task("t-8", "arn-9", "", "CannotPullContainerError", recent),
+			task("t-9", "arn-9", "", "CannotPullContainerError", recent),
+			task("t-10", "arn-9", "", "CannotPullContainerError", recent),
+		},
+	}
+	awsMock.MockListTasksPages(t, mockECSCli, tasks)
+	awsMock.MockDescribeTasks(t, mockECSCli, tasks)
+
+	c := &TaskFailuresChecker{
+		ecsCli:      mockECSCli,
+		clusterName: "test",
+		max:         2,
+		window:      15 * time.Minute,
+		reasons:     []string{"CannotPullContainerError", "CannotStartContainerError"},
+	}
+
+	cis := []*ecs.ContainerInstance{
+		{Ec2InstanceId: aws.String("i-0"), ContainerInstanceArn: aws.String("arn-0")},
+		{Ec2InstanceId: aws.String("i-1"), ContainerInstanceArn: aws.String("arn-1")},
+	}
+	vs, err := c.Unhealthy(&ClusterSnapshot{Time: now, ContainerInstances: cis})
+	if err != nil {
+		t.Fatalf("Unhealthy shouldn't give an error: %s", err)
+	}
+
+	if len(vs) != 1 || len(vs["i-0"]) != 1 {
+		t.Fatalf("Only i-0 should be unhealthy; got: %v", vs)
+	}
+	want := "3 tasks failed in 15m0s: CannotPullContainerError x2, CannotStartContainerError x1"
+	if got := vs["i-0"][0].Reason; !strings.HasPrefix(got, want) {
+		t.Errorf("Wrong verdict reason; got: %s, want: %s", got, want)
+	}
+}
+
+func TestTaskFailuresCheckerDescribesNewTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	now := time.Now().UTC()
+	task := func(arn, reason string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:              aws.String(arn),
+			ContainerInstanceArn: aws.String("arn-0"),
+			StoppedReason:        aws.String(reason),
+			StoppedAt:            aws.Time(now.Add(-time.Minute)),
+		}
+	}
+
+	tasks := map[string][]*ecs.Task{
+		"": {
+			task("t-0", "CannotPullContainerError"),
+			task("t-1", "Task stopped by user"),
+		},
+	}
+	requested := []string{}
+	awsMock.MockListTasksPages(t, mockECSCli, tasks)
+	awsMock.MockDescribeTasksRequested(t, mockECSCli, tasks, &requested)
+
+	c := &TaskFailuresChecker{
+		ecsCli:      mockECSCli,
+		clusterName: "test",
+		max:         1,
+		window:      15 * time.Minute,
+		reasons:     []string{"CannotPullContainerError"},
+	}
+	cis := []*ecs.ContainerInstance{
+		{Ec2InstanceId: aws.String("i-0"), ContainerInstanceArn: aws.String("arn-0")},
+	}
+	snapshot := &ClusterSnapshot{Time: now, ContainerInstances: cis}
+
+	tests := []struct {
+		newTask         *ecs.Task
+		refreshInterval time.Duration
+
+		wantRequested []string
+		wantUnhealthy bool
+	}{
+		{nil, 0, []string{"t-0", "t-1"}, false},
+		// Only the new tasks are described
+		{task("t-2", "CannotPullContainerError"), 0, []string{"t-0", "t-1", "t-2"}, true},
+		// The tasks aren't listed again until the refresh interval passes
+		{task("t-3", "CannotPullContainerError"), time.Hour, []string{"t-0", "t-1", "t-2"}, true},
+	}
+
+	for _, test := range tests {
+		if test.newTask != nil {
+			tasks[""] = append(tasks[""], test.newTask)
+		}
+		c.refreshInterval = test.refreshInterval
+
+		vs, err := c.Unhealthy(snapshot)
+		if err != nil {
+			t.Fatalf("%+v\n- Unhealthy shouldn't give an error: %s", test, err)
+		}
+		if got := strings.Join(requested, ","); got != strings.Join(test.wantRequested, ",") {
+			t.Errorf("%+v\n- Wrong described tasks; got: %s, want: %v", test, got, test.wantRequested)
+		}
+		if _, ok := vs["i-0"]; ok != test.wantUnhealthy {
+			t.Errorf("%+v\n- Wrong unhealthy state; got: %t, want: %t", test, ok, test.wantUnhealthy)
+		}
+	}
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/golang/mock/gomock"
 
@@ -319,6 +322,53 @@ func TestAgentCheckerMarkAfterTime(t *testing.T) {
 	}
 }
 
+func TestAgentCheckerMarkReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	tagged := map[string]map[string]string{}
+	awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+
+	a := &AgentChecker{
+		clusterName:      "test",
+		unhealthies:      make(map[string]*unhealthyInstance),
+		unhealthiesMutex: &sync.Mutex{},
+		markAfter:        30 * time.Second,
+		markTag:          MarkTag{key: "key", value: "value"},
+		ec2Cli:           mockEC2Cli,
+	}
+	started := time.Now().UTC().Add(-1 * time.Minute)
+	a.unhealthies["i-0"] = &unhealthyInstance{
+		instance: &ecs.ContainerInstance{},
+		started:  started,
+		verdicts: []*Verdict{
+			{Checker: "agent", Reason: "ECS agent disconnected"},
+			{Checker: "ec2-status", Reason: "instance status impaired"},
+		},
+	}
+	a.unhealthies["i-1"] = &unhealthyInstance{
+		instance: &ecs.ContainerInstance{},
+		started:  started,
+		verdicts: []*Verdict{{Checker: "exec", Reason: strings.Repeat("x", 300)}},
+	}
+
+	if err := a.Mark(); err != nil {
+		t.Fatalf("Mark shouldn't give an error: %s", err)
+	}
+
+	want := map[string]string{
+		"key":                 "value",
+		unhealthyReasonTagKey: "agent: ECS agent disconnected; ec2-status: instance status impaired",
+	}
+	if !reflect.DeepEqual(tagged["i-0"], want) {
+		t.Errorf("Wrong mark tags; got: %v, want: %v", tagged["i-0"], want)
+	}
+	if got := tagged["i-1"][unhealthyReasonTagKey]; len(got) != maxTagValueLength || !strings.HasPrefix(got, "exec: x") {
+		t.Errorf("The reason should be truncated to the tag value limit; got: %q", got)
+	}
+}
+
 func TestAgentCheckerMarkMultiple(t *testing.T) {
 	quantity := 100
 
@@ -367,3 +417,176 @@ func TestAgentCheckerMarkMultiple(t *testing.T) {
 		}
 	}
 }
+
+func TestAgentCheckerMarkAudit(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Set our mock desired result
+	marked := map[string]string{}
+	awsMock.MockCreateTags(t, mockEC2Cli, marked)
+
+	auditor := &testAuditor{}
+	a := &AgentChecker{
+		clusterName:      "test",
+		unhealthies:      make(map[string]*unhealthyInstance),
+		unhealthiesMutex: &sync.Mutex{},
+		markAfter:        30 * time.Second,
+		markTag:          MarkTag{key: "key", value: "value"},
+		auditor:          auditor,
+	}
+	a.ec2Cli = mockEC2Cli
+
+	started := time.Now().UTC().Add(-1 * time.Minute)
+	a.unhealthies["i-0"] = &unhealthyInstance{
+		instance: &ecs.ContainerInstance{},
+		started:  started,
+	}
+	a.history = map[string][]agentStatus{
+		"i-0": []agentStatus{{At: started, Connected: false}},
+	}
+
+	if err := a.Mark(); err != nil {
+		t.Errorf("Mark shouldn't give an error: %s", err)
+	}
+
+	if len(auditor.records) != 1 {
+		t.Fatalf("Wrong number of audit records; got: %d, want: %d", len(auditor.records), 1)
+	}
+	r := auditor.records[0]
+	if r.Action != auditActionCreateTags || r.InstanceID != "i-0" || r.Cluster != "test" {
+		t.Errorf("Wrong audit record; got: %+v", r)
+	}
+	if r.Evidence.FirstUnhealthy == nil || !r.Evidence.FirstUnhealthy.Equal(started) {
+		t.Errorf("Wrong first unhealthy evidence; got: %v, want: %s", r.Evidence.FirstUnhealthy, started)
+	}
+	if len(r.Evidence.AgentConnectedHistory) != 1 {
+		t.Errorf("Wrong agent connected history evidence; got: %d, want: %d", len(r.Evidence.AgentConnectedHistory), 1)
+	}
+}
+
+func TestAgentCheckerHistorySize(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+	awsMock.MockDescribeContainerInstancesHealthyUnhealthyQ(t, mockECSCli, 0, 1)
+
+	a := &AgentChecker{
+		clusterName:      "test",
+		unhealthies:      make(map[string]*unhealthyInstance),
+		unhealthiesMutex: &sync.Mutex{},
+	}
+	a.ecsCli = mockECSCli
+
+	for i := 0; i < checkAgentHistorySize+5; i++ {
+		if err := a.Check(); err != nil {
+			t.Errorf("Check shouldn't give an error: %s", err)
+		}
+	}
+
+	if len(a.history["i-0"]) != checkAgentHistorySize {
+		t.Errorf("Wrong history size; got: %d, want: %d", len(a.history["i-0"]), checkAgentHistorySize)
+	}
+}
+
+func TestAgentCheckerVerdicts(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	// Set our mock desired result
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 3)
+	awsMock.MockDescribeContainerInstancesHealthyUnhealthyQ(t, mockECSCli, 3, 0)
+
+	a := &AgentChecker{
+		clusterName:      "test",
+		unhealthies:      make(map[string]*unhealthyInstance),
+		unhealthiesMutex: &sync.Mutex{},
+		checker:          &testInstanceChecker{name: "custom", unhealthy: []string{"i-1"}},
+	}
+	a.ecsCli = mockECSCli
+
+	if err := a.Check(); err != nil {
+		t.Errorf("Check shouldn't give an error: %s", err)
+	}
+
+	ui, ok := a.unhealthies["i-1"]
+	if !ok || len(a.unhealthies) != 1 {
+		t.Fatalf("Wrong unhealthy instances; got: %v", a.unhealthies)
+	}
+	if ui.instance == nil {
+		t.Errorf("Unhealthy instance should have the container instance")
+	}
+	if len(ui.verdicts) != 1 || ui.verdicts[0].Checker != "custom" {
+		t.Errorf("Wrong verdicts on unhealthy instance; got: %v", ui.verdicts)
+	}
+}
+
+func TestAgentCheckerMarkDeregister(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	// Set our mock desired result
+	marked := map[string]string{}
+	awsMock.MockCreateTags(t, mockEC2Cli, marked)
+	var deregistered []string
+	awsMock.MockDeregisterContainerInstance(t, mockECSCli, &deregistered)
+
+	auditor := &testAuditor{}
+	a := &AgentChecker{
+		clusterName:      "test",
+		unhealthies:      make(map[string]*unhealthyInstance),
+		unhealthiesMutex: &sync.Mutex{},
+		markAfter:        30 * time.Second,
+		markTag:          MarkTag{key: "key", value: "value"},
+		auditor:          auditor,
+	}
+	a.ec2Cli = mockEC2Cli
+	a.ecsCli = mockECSCli
+
+	started := time.Now().UTC().Add(-1 * time.Minute)
+	a.unhealthies["i-0"] = &unhealthyInstance{
+		instance: &ecs.ContainerInstance{ContainerInstanceArn: aws.String("arn-0")},
+		started:  started,
+		verdicts: []*Verdict{{Checker: statusCheckerName, Deregister: true}},
+	}
+	a.unhealthies["i-1"] = &unhealthyInstance{
+		instance: &ecs.ContainerInstance{ContainerInstanceArn: aws.String("arn-1")},
+		started:  started,
+		verdicts: []*Verdict{
+			{Checker: statusCheckerName, Deregister: true},
+			{Checker: agentConnectedCheckerName},
+		},
+	}
+
+	if err := a.Mark(); err != nil {
+		t.Errorf("Mark shouldn't give an error: %s", err)
+	}
+
+	if len(deregistered) != 1 || deregistered[0] != "arn-0" {
+		t.Errorf("Wrong deregistered container instances; got: %v, want: %v", deregistered, []string{"arn-0"})
+	}
+	if _, ok := marked["i-1"]; !ok || len(marked) != 1 {
+		t.Errorf("Wrong marked instances; got: %v", marked)
+	}
+	if len(a.unhealthies) != 0 {
+		t.Errorf("Unhealthies should be empty; got: %d", len(a.unhealthies))
+	}
+
+	actions := map[string]string{}
+	for _, r := range auditor.records {
+		actions[r.InstanceID] = r.Action
+	}
+	if actions["i-0"] != auditActionDeregisterContainerInstance || actions["i-1"] != auditActionCreateTags {
+		t.Errorf("Wrong audit actions; got: %v", actions)
+	}
+}
@@ -2,13 +2,17 @@ package main
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 )
 
 const instanceStateRunningCode = "16"
@@ -17,8 +21,12 @@ const instanceStateRunningCode = "16"
 type Killer struct {
 	ec2Cli     ec2iface.EC2API
 	ec2WaitCli *ec2.EC2
+	elbCli     elbiface.ELBAPI
 	session    *session.Session
 
+	// the name of the cluster
+	clusterName string
+
 	// the step percent of cleaning instances
 	step int
 
@@ -27,13 +35,38 @@ type Killer struct {
 
 	// Should we wait to instance terminated status?
 	waitTerminate bool
+
+	// The auditor of the killing actions
+	auditor Auditor
+
+	// Waits the ELB connection draining
+	sleep func(d time.Duration)
+
+	// The commands run around each batch, nil without hooks
+	hooks *KillHooks
+
+	// Captures the state of each batch before terminating it, nil without capture
+	forensics *Forensics
+
+	// Replaces each batch before terminating it, nil without surge
+	surge *Surge
+
+	// Gates each batch on the cluster capacity, nil without gate
+	capacity *CapacityGate
 }
 
 // NewKiller creates a new killer
-func NewKiller(awsRegion string, stepPercent int, mtag string) (*Killer, error) {
+func NewKiller(clusterName string, awsRegion string, stepPercent int, mtag string, elbDeregister bool, hooks *KillHooks, forensics *Forensics, surge *Surge, capacity *CapacityGate, auditor Auditor) (*Killer, error) {
 	k := &Killer{
+		clusterName:   clusterName,
 		step:          stepPercent,
 		waitTerminate: true,
+		auditor:       auditor,
+		sleep:         time.Sleep,
+		hooks:         hooks,
+		forensics:     forensics,
+		surge:         surge,
+		capacity:      capacity,
 	}
 
 	// Set the tag
@@ -41,9 +74,9 @@ func NewKiller(awsRegion string, stepPercent int, mtag string) (*Killer, error)
 	k.markTag = MarkTag{splTag[0], splTag[1]}
 
 	// Create AWS session
-	s := session.New(&aws.Config{Region: aws.String(awsRegion)})
-	if s == nil {
-		return nil, fmt.Errorf("error creating aws session")
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
 	}
 	k.session = s
 
@@ -53,17 +86,22 @@ func NewKiller(awsRegion string, stepPercent int, mtag string) (*Killer, error)
 	// Create the wait client, the API interface doesn't implement the waiters
 	k.ec2WaitCli = ec2.New(s)
 
+	// Create the AWS ELB client only when enabled, the targets are deregistered from the ELBs
+	// before terminating them
+	if elbDeregister {
+		k.elbCli = elb.New(s)
+	}
+
 	return k, nil
 }
 
-// Clean will hunt and kill unhealthy instances
-func (k *Killer) Clean() error {
-	// Get all the marked instances
+// describeMarkedInstances returns the running instances that have the mark tag
+func describeMarkedInstances(cli ec2iface.EC2API, markTag MarkTag) ([]*ec2.Instance, error) {
 	params := &ec2.DescribeInstancesInput{
 		Filters: []*ec2.Filter{
 			{
-				Name:   aws.String(fmt.Sprintf("tag:%s", k.markTag.key)),
-				Values: []*string{aws.String(k.markTag.value)},
+				Name:   aws.String(fmt.Sprintf("tag:%s", markTag.key)),
+				Values: []*string{aws.String(markTag.value)},
 			},
 			{
 				Name:   aws.String("instance-state-code"),
@@ -74,7 +112,7 @@ func (k *Killer) Clean() error {
 
 	var instances []*ec2.Instance
 
-	err := k.ec2Cli.DescribeInstancesPages(params,
+	err := cli.DescribeInstancesPages(params,
 		func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
 			for _, r := range page.Reservations {
 				for _, i := range r.Instances {
@@ -84,21 +122,69 @@ func (k *Killer) Clean() error {
 			return true
 		})
 
+	if err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// instanceTag returns the value of the instance tag
+func instanceTag(i *ec2.Instance, key string) (string, bool) {
+	for _, t := range i.Tags {
+		if aws.StringValue(t.Key) == key {
+			return aws.StringValue(t.Value), true
+		}
+	}
+	return "", false
+}
+
+// Clean will hunt and kill unhealthy instances
+func (k *Killer) Clean() error {
+	log := componentLog(k.clusterName, componentGC)
+
+	// Get all the marked instances
+	instances, err := describeMarkedInstances(k.ec2Cli, k.markTag)
 	if err != nil {
 		return err
 	}
 	if len(instances) == 0 {
-		logrus.Debugf("No targets to kill")
+		log.Debug("No targets to kill")
+		return nil
+	}
+
+	return k.kill(instances)
+}
+
+// Remediate will kill the instances, none of them will be left for the next step
+func (k *Killer) Remediate(instances []*ec2.Instance) ([]*ec2.Instance, error) {
+	return nil, k.kill(instances)
+}
+
+// kill will kill the instances in batches
+func (k *Killer) kill(instances []*ec2.Instance) error {
+	log := componentLog(k.clusterName, componentGC)
+	log.WithField("total", len(instances)).Debug("Killing targets")
+
+	// Don't ask the pre kill hook on every collection after a veto or a delay
+	if d := k.hooks.retryIn(); d > 0 {
+		log.WithField("retry_in", d).Info("Waiting to retry the pre kill hook")
 		return nil
 	}
-	logrus.Debugf("Killing targets: %d", len(instances))
+
+	// A previous run aborted the batches, don't start new ones until the capacity recovers
+	if err := k.capacity.ready(); err != nil {
+		return err
+	}
 
 	// Get the number of instances per step
 	n := k.step * len(instances) / 100
 	if n == 0 {
 		n = 1
 	}
-	logrus.Infof("Start killing in batches of %d", n)
+	log.WithField("batch_size", n).Info("Start killing in batches")
+
+	// The surged ones finish the batch that raised the capacity
+	instances = surgedFirst(instances)
 
 	// Start killing them in steps and wait until it was terminated
 	for i := 0; i < len(instances); i = i + n {
@@ -109,39 +195,190 @@ func (k *Killer) Clean() error {
 			targets = instances[i : i+n]
 		}
 
-		// Kill
-		ids := make([]*string, len(targets))
-		for it, t := range targets {
-			ids[it] = t.InstanceId
-		}
-
+		batch := i/n + 1
 		if len(targets) == 0 {
-			logrus.Debugf("Nothing to kill")
+			log.WithField(logFieldBatch, batch).Debug("Nothing to kill")
 			return nil
 		}
 
-		params := &ec2.TerminateInstancesInput{
-			InstanceIds: ids,
+		// The capacity before the batch, the next batch waits until it's recovered
+		level, err := k.capacity.level(targets)
+		if err != nil {
+			return err
+		}
+
+		// Ask before killing them, a vetoed or delayed batch stops the run and the remaining
+		// targets stay marked for the collection after the hook retry
+		if err := k.hooks.preKill(batch, targets); err != nil {
+			remaining := len(instances) - i
+			if _, ok := err.(*hookDelayedError); ok {
+				log.WithError(err).WithFields(logrus.Fields{
+					logFieldBatch: batch,
+					"remaining":   remaining,
+				}).Info("Batch delayed by the pre kill hook")
+				k.hooks.postKill(batch, targets, hookOutcomeDelayed, err)
+				return nil
+			}
+			log.WithError(err).WithFields(logrus.Fields{
+				logFieldBatch: batch,
+				"remaining":   remaining,
+			}).Warning("Batch vetoed by the pre kill hook, stopping the remaining batches")
+			k.hooks.postKill(batch, targets, hookOutcomeVetoed, err)
+			return fmt.Errorf("batch %d vetoed by the pre kill hook, %d targets left: %s", batch, remaining, err)
 		}
 
-		// Finish him!
-		_, err = k.ec2Cli.TerminateInstances(params)
+		killed, err := k.killBatch(targets, batch)
 		if err != nil {
+			k.hooks.postKill(batch, targets, hookOutcomeFailed, err)
 			return err
 		}
+		if !killed {
+			log.WithFields(logrus.Fields{
+				logFieldBatch: batch,
+				"remaining":   len(instances) - i,
+			}).Info("Batch waiting its surge replacements, the next collection carries on")
+			k.hooks.postKill(batch, targets, hookOutcomeDelayed, nil)
+			return nil
+		}
+		k.hooks.postKill(batch, targets, hookOutcomeTerminated, nil)
 
-		// Wait if wanted
-		if k.waitTerminate {
-			paramsWait := &ec2.DescribeInstancesInput{
-				InstanceIds: ids,
-			}
-			err = k.ec2WaitCli.WaitUntilInstanceTerminated(paramsWait)
-			if err != nil {
-				return err
-			}
+		// Don't continue if the replacements don't join the cluster
+		remaining := instances[i+len(targets):]
+		if len(remaining) == 0 {
+			continue
+		}
+		if err := k.capacity.wait(level, batch); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				logFieldBatch: batch,
+				"remaining":   len(remaining),
+			}).Error("Cluster capacity not recovered, aborting the remaining batches")
+			k.hooks.postKill(batch+1, remaining, hookOutcomeAborted, err)
+			return fmt.Errorf("aborted %d targets: %s", len(remaining), err)
 		}
-		logrus.Infof("Killed %d targets", len(ids))
 	}
 
 	return nil
 }
+
+// surgedFirst returns the instances with the surged ones first, keeping their order
+func surgedFirst(instances []*ec2.Instance) []*ec2.Instance {
+	surged := make([]*ec2.Instance, 0, len(instances))
+	var rest []*ec2.Instance
+	for _, i := range instances {
+		if _, ok := instanceTag(i, surgeTagKey); ok {
+			surged = append(surged, i)
+		} else {
+			rest = append(rest, i)
+		}
+	}
+	return append(surged, rest...)
+}
+
+// killBatch will kill the instances of a batch, it returns false without killing them if their
+// surge replacements aren't ready yet
+func (k *Killer) killBatch(targets []*ec2.Instance, batch int) (bool, error) {
+	log := componentLog(k.clusterName, componentGC)
+
+	ids := make([]*string, len(targets))
+	for it, t := range targets {
+		ids[it] = t.InstanceId
+	}
+
+	// Keep something to debug them
+	if err := k.forensics.capture(targets, batch, fmt.Sprintf("marked unhealthy with %s:%s", k.markTag.key, k.markTag.value)); err != nil {
+		return false, err
+	}
+
+	// Make before break
+	surged, ready, err := k.surge.replace(targets, batch)
+	if err != nil {
+		return false, fmt.Errorf("error surging replacements: %s", err)
+	}
+	if !ready {
+		return false, nil
+	}
+
+	// Stop the traffic to them
+	if err := k.deregisterFromELBs(targets, batch); err != nil {
+		return false, err
+	}
+
+	// Finish him! the surged ones give back the raised capacity
+	var rest []*ec2.Instance
+	for _, t := range targets {
+		if _, ok := surged[aws.StringValue(t.InstanceId)]; !ok {
+			rest = append(rest, t)
+			continue
+		}
+		err := k.surge.terminate(t)
+		k.audit(auditActionTerminateInstanceInAutoScalingGroup, []*ec2.Instance{t}, batch, err)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(rest) > 0 {
+		restIDs := make([]*string, len(rest))
+		for it, t := range rest {
+			restIDs[it] = t.InstanceId
+		}
+		params := &ec2.TerminateInstancesInput{
+			InstanceIds: restIDs,
+		}
+		_, err := k.ec2Cli.TerminateInstances(params)
+		k.audit(auditActionTerminateInstances, rest, batch, err)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// Wait if wanted
+	if k.waitTerminate {
+		paramsWait := &ec2.DescribeInstancesInput{
+			InstanceIds: ids,
+		}
+		if err := k.ec2WaitCli.WaitUntilInstanceTerminated(paramsWait); err != nil {
+			return false, err
+		}
+	}
+	for _, id := range ids {
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: aws.StringValue(id),
+			logFieldBatch:      batch,
+		}).Info("Killed target")
+	}
+	return true, nil
+}
+
+// audit will record the killing of the instances of a batch
+func (k *Killer) audit(action string, instances []*ec2.Instance, batch int, actionErr error) {
+	if k.auditor == nil {
+		return
+	}
+
+	records := make([]*AuditRecord, len(instances))
+	for i, ins := range instances {
+		r := &AuditRecord{
+			Time:       time.Now().UTC(),
+			Cluster:    k.clusterName,
+			Component:  componentGC,
+			Action:     action,
+			InstanceID: aws.StringValue(ins.InstanceId),
+			Tags:       map[string]string{},
+			Evidence: AuditEvidence{
+				Batch:      batch,
+				Thresholds: map[string]string{"gc.step.percent": strconv.Itoa(k.step)},
+			},
+		}
+		for _, t := range ins.Tags {
+			r.Tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+		if actionErr != nil {
+			r.Error = actionErr.Error()
+		}
+		records[i] = r
+	}
+
+	if err := k.auditor.Audit(records...); err != nil {
+		componentLog(k.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// The error code when the agent is already on the latest version
+const agentNoUpdateAvailableCode = "NoUpdateAvailableException"
+
+// AgentUpdater will update the ECS agent of the marked instances in batches, a new update
+// starts when one of the batch finishes
+type AgentUpdater struct {
+	ecsCli  ecsiface.ECSAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The number of instances updating at the same time
+	batchSize int
+
+	// The time an update has to finish
+	timeout time.Duration
+
+	// The auditor of the updating actions
+	auditor Auditor
+}
+
+// NewAgentUpdater creates a new agent updater
+func NewAgentUpdater(clusterName string, awsRegion string, batchSize int, timeout time.Duration, auditor Auditor) (*AgentUpdater, error) {
+	u := &AgentUpdater{
+		clusterName: clusterName,
+		batchSize:   batchSize,
+		timeout:     timeout,
+		auditor:     auditor,
+	}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	u.session = s
+
+	// Create AWS ecs client
+	u.ecsCli = ecs.New(s)
+
+	return u, nil
+}
+
+// Limit returns the instances that can start the update without exceeding the batch size with
+// the updates in progress, the unregistered ones are left to be skipped
+func (u *AgentUpdater) Limit(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) []*ec2.Instance {
+	n := u.batchSize
+	if n <= 0 {
+		n = 1
+	}
+	for _, ci := range registered {
+		if agentUpdating(ci) {
+			n--
+		}
+	}
+
+	var allowed []*ec2.Instance
+	for _, i := range instances {
+		ci, ok := registered[aws.StringValue(i.InstanceId)]
+		switch {
+		case !ok:
+			// Not registered, it's skipped by the remediation
+		case agentUpdating(ci):
+			continue
+		case n <= 0:
+			continue
+		default:
+			n--
+		}
+		allowed = append(allowed, i)
+	}
+
+	if waiting := len(instances) - len(allowed); waiting > 0 {
+		componentLog(u.clusterName, componentGC).WithField("waiting", waiting).Debug("Waiting the ECS agent updates in progress")
+	}
+	return allowed
+}
+
+// Remediate will start the agent update of the instances without waiting it, the instances that
+// aren't registered on the cluster or whose update request failed are skipped
+func (u *AgentUpdater) Remediate(instances []*ec2.Instance) ([]*ec2.Instance, error) {
+	log := componentLog(u.clusterName, componentGC)
+
+	cis, err := describeContainerInstances(u.ecsCli, u.clusterName)
+	if err != nil {
+		return nil, err
+	}
+	arns := map[string]*string{}
+	for _, ci := range cis {
+		arns[aws.StringValue(ci.Ec2InstanceId)] = ci.ContainerInstanceArn
+	}
+
+	var skipped []*ec2.Instance
+	for _, i := range instances {
+		id := aws.StringValue(i.InstanceId)
+		l := log.WithFields(logrus.Fields{
+			logFieldInstanceID: id,
+			logFieldAction:     auditActionUpdateContainerAgent,
+		})
+		arn, ok := arns[id]
+		if !ok {
+			l.Warning("Instance not registered on the cluster, can't update the agent")
+			skipped = append(skipped, i)
+			continue
+		}
+
+		params := &ecs.UpdateContainerAgentInput{
+			Cluster:           aws.String(u.clusterName),
+			ContainerInstance: arn,
+		}
+		_, err := u.ecsCli.UpdateContainerAgent(params)
+		u.audit(i.InstanceId, err)
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == agentNoUpdateAvailableCode {
+				l.Info("ECS agent already updated")
+				continue
+			}
+			l.WithError(err).Error("Error updating ECS agent")
+			skipped = append(skipped, i)
+			continue
+		}
+		l.Info("Updating ECS agent")
+	}
+
+	return skipped, nil
+}
+
+// Failed returns the instances whose agent update failed
+func (u *AgentUpdater) Failed(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) ([]*ec2.Instance, error) {
+	var failed []*ec2.Instance
+	for _, i := range instances {
+		id := aws.StringValue(i.InstanceId)
+		if ci, ok := registered[id]; ok && aws.StringValue(ci.AgentUpdateStatus) == ecs.AgentUpdateStatusFailed {
+			componentLog(u.clusterName, componentGC).WithField(logFieldInstanceID, id).Warning("ECS agent update failed")
+			failed = append(failed, i)
+		}
+	}
+	return failed, nil
+}
+
+// Remediated returns true if the agent update of the instance isn't failed nor in progress,
+// so the escalation doesn't unmark an instance whose update failed or timed out
+func (u *AgentUpdater) Remediated(ci *ecs.ContainerInstance) bool {
+	switch aws.StringValue(ci.AgentUpdateStatus) {
+	case "", ecs.AgentUpdateStatusUpdated:
+		return true
+	}
+	return false
+}
+
+// agentUpdating returns true if the agent update of the container instance is in progress
+func agentUpdating(ci *ecs.ContainerInstance) bool {
+	switch aws.StringValue(ci.AgentUpdateStatus) {
+	case ecs.AgentUpdateStatusPending, ecs.AgentUpdateStatusStaging, ecs.AgentUpdateStatusStaged, ecs.AgentUpdateStatusUpdating:
+		return true
+	}
+	return false
+}
+
+// audit will record the agent update of an instance
+func (u *AgentUpdater) audit(id *string, actionErr error) {
+	if u.auditor == nil {
+		return
+	}
+
+	r := &AuditRecord{
+		Time:       time.Now().UTC(),
+		Cluster:    u.clusterName,
+		Component:  componentGC,
+		Action:     auditActionUpdateContainerAgent,
+		InstanceID: aws.StringValue(id),
+		Evidence: AuditEvidence{
+			Thresholds: map[string]string{
+				"agent.update.batch":   strconv.Itoa(u.batchSize),
+				"agent.update.timeout": u.timeout.String(),
+			},
+		},
+	}
+	if actionErr != nil {
+		r.Error = actionErr.Error()
+	}
+
+	if err := u.auditor.Audit(r); err != nil {
+		componentLog(u.clusterName, componentGC).WithError(err).WithField(logFieldAction, r.Action).Error("Error auditing")
+	}
+}
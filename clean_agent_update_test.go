@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestAgentUpdaterRemediate(t *testing.T) {
+	tests := []struct {
+		errCode string
+
+		wantSkipped int
+	}{
+		{"", 0},
+		// Already updated agents
+		{agentNoUpdateAvailableCode, 0},
+		// Failed update requests
+		{"UpdateInProgressException", 3},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		var instances []*ec2.Instance
+		var cis []*ecs.ContainerInstance
+		for i := 0; i < 3; i++ {
+			id := aws.String(fmt.Sprintf("i-%d", i))
+			instances = append(instances, &ec2.Instance{InstanceId: id})
+			cis = append(cis, &ecs.ContainerInstance{
+				Ec2InstanceId:        id,
+				ContainerInstanceArn: aws.String("arn-" + *id),
+			})
+		}
+		// An instance that isn't registered on the cluster
+		instances = append(instances, &ec2.Instance{InstanceId: aws.String("unregistered")})
+
+		// Set our mock desired result
+		updated := []string{}
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, len(cis))
+		awsMock.MockDescribeContainerInstances(t, mockECSCli, cis...)
+		awsMock.MockUpdateContainerAgent(t, mockECSCli, &updated, test.errCode)
+
+		u := &AgentUpdater{
+			clusterName: "test",
+			batchSize:   1,
+			timeout:     time.Minute,
+		}
+		u.ecsCli = mockECSCli
+
+		skipped, err := u.Remediate(instances)
+		if err != nil {
+			t.Errorf("%+v\n- Remediate shouldn't give an error: %s", test, err)
+		}
+
+		if len(updated) != len(cis) {
+			t.Errorf("%+v\n- Wrong number of updates; got: %d, want: %d", test, len(updated), len(cis))
+		}
+		// The unregistered one is always skipped
+		if len(skipped) != test.wantSkipped+1 {
+			t.Errorf("%+v\n- Wrong number of skipped instances; got: %d, want: %d", test, len(skipped), test.wantSkipped+1)
+		}
+	}
+}
+
+func TestAgentUpdaterLimit(t *testing.T) {
+	tests := []struct {
+		batchSize int
+		updating  int
+
+		wantAllowed int
+	}{
+		{2, 0, 2},
+		{2, 1, 1},
+		{2, 2, 0},
+		{5, 0, 3},
+		{0, 0, 1},
+	}
+
+	for _, test := range tests {
+		registered := map[string]*ecs.ContainerInstance{}
+		for i := 0; i < test.updating; i++ {
+			id := fmt.Sprintf("u-%d", i)
+			registered[id] = &ecs.ContainerInstance{Ec2InstanceId: aws.String(id), AgentUpdateStatus: aws.String(ecs.AgentUpdateStatusStaging)}
+		}
+		var instances []*ec2.Instance
+		for i := 0; i < 3; i++ {
+			id := fmt.Sprintf("i-%d", i)
+			registered[id] = &ecs.ContainerInstance{Ec2InstanceId: aws.String(id), AgentUpdateStatus: aws.String(ecs.AgentUpdateStatusFailed)}
+			instances = append(instances, &ec2.Instance{InstanceId: aws.String(id)})
+		}
+		// The unregistered instances are always allowed
+		instances = append(instances, &ec2.Instance{InstanceId: aws.String("unregistered")})
+
+		u := &AgentUpdater{clusterName: "test", batchSize: test.batchSize}
+		if got := u.Limit(instances, registered); len(got) != test.wantAllowed+1 {
+			t.Errorf("%+v\n- Wrong number of allowed instances; got: %d, want: %d", test, len(got), test.wantAllowed+1)
+		}
+	}
+}
+
+func TestAgentUpdaterFailed(t *testing.T) {
+	registered := map[string]*ecs.ContainerInstance{}
+	var instances []*ec2.Instance
+	for i, s := range []string{ecs.AgentUpdateStatusUpdated, ecs.AgentUpdateStatusFailed, ecs.AgentUpdateStatusUpdating} {
+		id := fmt.Sprintf("i-%d", i)
+		registered[id] = &ecs.ContainerInstance{Ec2InstanceId: aws.String(id), AgentUpdateStatus: aws.String(s)}
+		instances = append(instances, &ec2.Instance{InstanceId: aws.String(id)})
+	}
+
+	u := &AgentUpdater{clusterName: "test"}
+	failed, err := u.Failed(instances, registered)
+	if err != nil {
+		t.Fatalf("Failed shouldn't give an error: %s", err)
+	}
+	if len(failed) != 1 || aws.StringValue(failed[0].InstanceId) != "i-1" {
+		t.Errorf("Only the failed update should be failed; got: %d instances", len(failed))
+	}
+}
+
+func TestAgentUpdaterRemediated(t *testing.T) {
+	tests := []struct {
+		status string
+
+		want bool
+	}{
+		{"", true},
+		{ecs.AgentUpdateStatusUpdated, true},
+		{ecs.AgentUpdateStatusFailed, false},
+		{ecs.AgentUpdateStatusStaging, false},
+		{ecs.AgentUpdateStatusUpdating, false},
+	}
+
+	for _, test := range tests {
+		ci := &ecs.ContainerInstance{Ec2InstanceId: aws.String("i-0")}
+		if test.status != "" {
+			ci.AgentUpdateStatus = aws.String(test.status)
+		}
+
+		u := &AgentUpdater{clusterName: "test"}
+		if got := u.Remediated(ci); got != test.want {
+			t.Errorf("%+v\n- Wrong remediation; got: %t, want: %t", test, got, test.want)
+		}
+	}
+}
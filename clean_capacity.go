@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+const (
+	// The interval between the checks of the cluster capacity
+	capacityGatePollInterval = 15 * time.Second
+
+	// The alert metric of the capacity gate, 1 when tripped and 0 when recovered
+	capacityGateMetric = "CapacityGateTripped"
+
+	// The tag that AWS sets on the instances of an autoscaling group
+	autoScalingGroupTagKey = "aws:autoscaling:groupName"
+)
+
+// CapacityGate gates each batch of terminated instances on the cluster capacity, the next batch
+// waits until the connected container instances of the cluster are back to the level they had
+// before the previous batch, so a broken AMI or launch configuration can't empty the cluster.
+// When the capacity doesn't recover the gate trips, no batch starts on the next collections
+// until the capacity is back to that level or the expiry passes
+type CapacityGate struct {
+	ecsCli ecsiface.ECSAPI
+	cwCli  cloudwatchiface.CloudWatchAPI
+
+	// the name of the cluster
+	clusterName string
+
+	// The maximum time waiting for the capacity, after it the remaining batches are aborted
+	timeout time.Duration
+
+	// The time between the checks of the capacity
+	pollInterval time.Duration
+
+	// The maximum time the gate is tripped, 0 keeps it until the capacity recovers
+	expiry time.Duration
+
+	// The CloudWatch namespace of the alert metric
+	namespace string
+
+	// The gate is tripped until the capacity is back to the wanted level
+	tripped   bool
+	trippedAt time.Time
+	want      int
+	checked   time.Time
+}
+
+// NewCapacityGate creates a CapacityGate
+func NewCapacityGate(clusterName string, awsRegion string, timeout, expiry time.Duration, namespace string) (*CapacityGate, error) {
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CapacityGate{
+		ecsCli:       ecs.New(s),
+		cwCli:        cloudwatch.New(s),
+		clusterName:  clusterName,
+		timeout:      timeout,
+		expiry:       expiry,
+		pollInterval: capacityGatePollInterval,
+		namespace:    namespace,
+	}, nil
+}
+
+// level returns the number of active container instances with the agent connected, without the
+// targets that aren't on an autoscaling group because nothing replaces them
+func (g *CapacityGate) level(targets []*ec2.Instance) (int, error) {
+	if g == nil {
+		return 0, nil
+	}
+	cis, err := describeContainerInstances(g.ecsCli, g.clusterName)
+	if err != nil {
+		return 0, err
+	}
+	connected := map[string]bool{}
+	for _, ci := range cis {
+		if aws.BoolValue(ci.AgentConnected) && aws.StringValue(ci.Status) == containerInstanceStatusActive {
+			connected[aws.StringValue(ci.Ec2InstanceId)] = true
+		}
+	}
+	level := len(connected)
+	for _, t := range targets {
+		if _, ok := instanceTag(t, autoScalingGroupTagKey); !ok && connected[aws.StringValue(t.InstanceId)] {
+			level--
+		}
+	}
+	return level, nil
+}
+
+// ready returns an error while the gate is tripped, the capacity is checked again once per poll
+// interval and the gate is closed when it's back to the level before the batch that tripped it
+// or after the expiry
+func (g *CapacityGate) ready() error {
+	if g == nil || !g.tripped {
+		return nil
+	}
+	log := componentLog(g.clusterName, componentGC)
+	if g.expiry > 0 && time.Since(g.trippedAt) >= g.expiry {
+		log.WithFields(logrus.Fields{
+			"want":   g.want,
+			"expiry": g.expiry,
+		}).Error("Cluster capacity gate expired, closing it without the capacity recovered")
+		g.tripped = false
+		g.alert(false)
+		return nil
+	}
+	if time.Since(g.checked) < g.pollInterval {
+		return fmt.Errorf("cluster capacity gate tripped, waiting %d connected container instances", g.want)
+	}
+	g.checked = time.Now()
+
+	connected, err := g.level(nil)
+	if err != nil {
+		return err
+	}
+	if connected < g.want {
+		return fmt.Errorf("cluster capacity gate tripped, %d of %d connected container instances", connected, g.want)
+	}
+
+	log.WithFields(logrus.Fields{
+		"connected": connected,
+		"want":      g.want,
+	}).Info("Cluster capacity recovered, closing the capacity gate")
+	g.tripped = false
+	g.alert(false)
+	return nil
+}
+
+// wait waits until the cluster capacity is back to the level, it returns an error and trips the
+// gate on timeout
+func (g *CapacityGate) wait(level int, batch int) error {
+	if g == nil {
+		return nil
+	}
+	log := componentLog(g.clusterName, componentGC).WithField(logFieldBatch, batch)
+
+	deadline := time.Now().Add(g.timeout)
+	for {
+		connected, err := g.level(nil)
+		if err != nil {
+			return err
+		}
+
+		fields := logrus.Fields{"connected": connected, "want": level}
+		if connected >= level {
+			log.WithFields(fields).Info("Cluster capacity recovered after the batch")
+			return nil
+		}
+		if time.Now().After(deadline) {
+			g.tripped = true
+			g.trippedAt = time.Now()
+			g.want = level
+			g.checked = time.Now()
+			g.alert(true)
+			return fmt.Errorf("cluster capacity not recovered after %s, %d of %d connected container instances", g.timeout, connected, level)
+		}
+		log.WithFields(fields).Debug("Waiting cluster capacity")
+		time.Sleep(g.pollInterval)
+	}
+}
+
+// alert publishes the alert metric of the gate, its failures are only logged
+func (g *CapacityGate) alert(tripped bool) {
+	if g.cwCli == nil {
+		return
+	}
+	var v float64
+	if tripped {
+		v = 1
+	}
+	params := &cloudwatch.PutMetricDataInput{
+		Namespace: aws.String(g.namespace),
+		MetricData: []*cloudwatch.MetricDatum{
+			{
+				MetricName: aws.String(capacityGateMetric),
+				Dimensions: []*cloudwatch.Dimension{
+					{Name: aws.String(reconcileMetricDimension), Value: aws.String(g.clusterName)},
+				},
+				Timestamp: aws.Time(time.Now().UTC()),
+				Unit:      aws.String(cloudwatch.StandardUnitCount),
+				Value:     aws.Float64(v),
+			},
+		},
+	}
+	if _, err := g.cwCli.PutMetricData(params); err != nil {
+		componentLog(g.clusterName, componentGC).WithError(err).WithField("metric", capacityGateMetric).Error("Error publishing the capacity gate alert")
+	}
+}
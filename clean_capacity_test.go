@@ -0,0 +1,190 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestKillerCapacityGate(t *testing.T) {
+	tests := []struct {
+		sequence [][]*ecs.ContainerInstance
+		timeout  time.Duration
+
+		wantCalls int
+		wantError bool
+	}{
+		// The capacity recovers after the first batch
+		{
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-7", "i-8", "i-9"),
+				surgeTestContainerInstances("i-7", "i-8"),
+				surgeTestContainerInstances("i-7", "i-8", "i-10"),
+			},
+			timeout:   time.Minute,
+			wantCalls: 2,
+		},
+		// The capacity doesn't recover, the second batch is aborted
+		{
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-7", "i-8", "i-9"),
+				surgeTestContainerInstances("i-7", "i-8"),
+			},
+			timeout:   10 * time.Millisecond,
+			wantCalls: 1,
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		ctrl := gomock.NewController(t)
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		// Two batches: i-0,i-1 and i-2,i-3
+		terminatedCalls := []map[string]*ec2.InstanceState{}
+		awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 4, 0)
+		awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+		awsMock.MockDescribeContainerInstancesSequence(t, mockECSCli, test.sequence...)
+
+		k := &Killer{
+			clusterName: "test",
+			markTag:     MarkTag{"key", "value"},
+			step:        50,
+			ec2Cli:      mockEC2Cli,
+			capacity: &CapacityGate{
+				ecsCli:      mockECSCli,
+				clusterName: "test",
+				timeout:     test.timeout,
+			},
+		}
+
+		err := k.Clean()
+		if test.wantError != (err != nil) {
+			t.Errorf("%+v\n- Wrong Clean error; got: %v", test, err)
+		}
+
+		// The tripped gate doesn't start new batches while the capacity isn't recovered
+		if test.wantError {
+			if err := k.Clean(); err == nil {
+				t.Errorf("%+v\n- Clean should give an error with the tripped gate, it didn't", test)
+			}
+		}
+		ctrl.Finish()
+		if len(terminatedCalls) != test.wantCalls {
+			t.Errorf("%+v\n- Wrong number of calls to terminate instances: got: %d, want: %d", test, len(terminatedCalls), test.wantCalls)
+		}
+	}
+}
+
+func TestCapacityGateTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+	mockCWCli := sdk.NewMockCloudWatchAPI(ctrl)
+
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+	awsMock.MockDescribeContainerInstancesSequence(t, mockECSCli,
+		surgeTestContainerInstances("i-7", "i-8"),
+		surgeTestContainerInstances("i-7", "i-8"),
+		surgeTestContainerInstances("i-7", "i-8", "i-9"),
+	)
+	metrics := map[string]float64{}
+	awsMock.MockPutMetricData(t, mockCWCli, metrics)
+
+	g := &CapacityGate{
+		ecsCli:       mockECSCli,
+		cwCli:        mockCWCli,
+		clusterName:  "test",
+		timeout:      10 * time.Millisecond,
+		pollInterval: 50 * time.Millisecond,
+		namespace:    "ECSWatcher",
+	}
+
+	if err := g.wait(3, 1); err == nil {
+		t.Fatalf("Wait should give an error when the capacity doesn't recover, it didn't")
+	}
+	if want := map[string]float64{capacityGateMetric: 1}; !reflect.DeepEqual(metrics, want) {
+		t.Errorf("Wrong alert metrics after tripping; got: %v, want: %v", metrics, want)
+	}
+
+	// Not checked again until the poll interval
+	if err := g.ready(); err == nil {
+		t.Errorf("The tripped gate shouldn't be ready, it is")
+	}
+
+	time.Sleep(g.pollInterval)
+	if err := g.ready(); err != nil {
+		t.Errorf("The gate should be ready after the capacity recovers, it isn't: %s", err)
+	}
+	if want := map[string]float64{capacityGateMetric: 0}; !reflect.DeepEqual(metrics, want) {
+		t.Errorf("Wrong alert metrics after recovering; got: %v, want: %v", metrics, want)
+	}
+}
+
+func TestCapacityGateLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+	awsMock.MockDescribeContainerInstancesSequence(t, mockECSCli, surgeTestContainerInstances("i-7", "i-8", "i-9"))
+
+	// i-9 isn't on an autoscaling group so it isn't replaced, i-5 isn't connected
+	targets := []*ec2.Instance{
+		{
+			InstanceId: aws.String("i-8"),
+			Tags:       []*ec2.Tag{{Key: aws.String(autoScalingGroupTagKey), Value: aws.String("asg-0")}},
+		},
+		{InstanceId: aws.String("i-9")},
+		{InstanceId: aws.String("i-5")},
+	}
+
+	g := &CapacityGate{ecsCli: mockECSCli, clusterName: "test"}
+	level, err := g.level(targets)
+	if err != nil {
+		t.Fatalf("Level shouldn't give an error: %s", err)
+	}
+	if level != 2 {
+		t.Errorf("Wrong level; got: %d, want: 2", level)
+	}
+}
+
+func TestCapacityGateExpiry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCWCli := sdk.NewMockCloudWatchAPI(ctrl)
+
+	metrics := map[string]float64{}
+	awsMock.MockPutMetricData(t, mockCWCli, metrics)
+
+	// The capacity isn't checked, the gate expired
+	g := &CapacityGate{
+		cwCli:       mockCWCli,
+		clusterName: "test",
+		expiry:      time.Hour,
+		namespace:   "ECSWatcher",
+		tripped:     true,
+		trippedAt:   time.Now().Add(-2 * time.Hour),
+		want:        3,
+	}
+
+	if err := g.ready(); err != nil {
+		t.Errorf("The expired gate should be ready, it isn't: %s", err)
+	}
+	if g.tripped {
+		t.Errorf("The expired gate shouldn't be tripped, it is")
+	}
+	if want := map[string]float64{capacityGateMetric: 0}; !reflect.DeepEqual(metrics, want) {
+		t.Errorf("Wrong alert metrics after expiring; got: %v, want: %v", metrics, want)
+	}
+}
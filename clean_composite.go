@@ -0,0 +1,37 @@
+package main
+
+import "fmt"
+
+// CompositeCleaner runs multiple cleaners in order on each garbage collection iteration
+type CompositeCleaner struct {
+	// the name of the cluster
+	clusterName string
+
+	cleaners []Cleaner
+}
+
+// NewCompositeCleaner creates a CompositeCleaner
+func NewCompositeCleaner(clusterName string, cleaners ...Cleaner) *CompositeCleaner {
+	return &CompositeCleaner{
+		clusterName: clusterName,
+		cleaners:    cleaners,
+	}
+}
+
+// Clean will run all the cleaners, a failing cleaner doesn't stop the next ones
+func (c *CompositeCleaner) Clean() error {
+	log := componentLog(c.clusterName, componentGC)
+
+	failed := 0
+	for _, cl := range c.cleaners {
+		if err := cl.Clean(); err != nil {
+			log.WithError(err).Error("Error running cleaner")
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d cleaners failed", failed, len(c.cleaners))
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestCompositeCleaner(t *testing.T) {
+	tests := []struct {
+		errs []bool
+
+		wantError bool
+	}{
+		{[]bool{false}, false},
+		{[]bool{false, false}, false},
+		{[]bool{true, false}, true},
+		{[]bool{false, true}, true},
+	}
+
+	for _, test := range tests {
+		var cleaners []Cleaner
+		var tcs []*testCleaner
+		for _, e := range test.errs {
+			tc := &testCleaner{cleanReturnError: e}
+			tcs = append(tcs, tc)
+			cleaners = append(cleaners, tc)
+		}
+
+		c := NewCompositeCleaner("test", cleaners...)
+		err := c.Clean()
+		if test.wantError != (err != nil) {
+			t.Errorf("%+v\n- Wrong error; got: %v, want error: %t", test, err, test.wantError)
+		}
+
+		// A failing cleaner doesn't stop the others
+		for i, tc := range tcs {
+			if tc.cleanCounter != 1 {
+				t.Errorf("%+v\n- Cleaner %d should run once; got: %d", test, i, tc.cleanCounter)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+)
+
+// deregisterFromELBs deregisters the instances from every classic ELB where they are registered
+// and waits the longest connection draining timeout of those ELBs, so the instances stop
+// receiving traffic before they are terminated
+func (k *Killer) deregisterFromELBs(targets []*ec2.Instance, batch int) error {
+	if k.elbCli == nil {
+		return nil
+	}
+	log := componentLog(k.clusterName, componentGC)
+
+	byID := map[string]*ec2.Instance{}
+	for _, t := range targets {
+		byID[aws.StringValue(t.InstanceId)] = t
+	}
+
+	// The targets registered on each ELB
+	registered := map[string][]*ec2.Instance{}
+	var names []string
+	err := k.elbCli.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{},
+		func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+			for _, lb := range page.LoadBalancerDescriptions {
+				name := aws.StringValue(lb.LoadBalancerName)
+				for _, i := range lb.Instances {
+					t, ok := byID[aws.StringValue(i.InstanceId)]
+					if !ok {
+						continue
+					}
+					if _, ok := registered[name]; !ok {
+						names = append(names, name)
+					}
+					registered[name] = append(registered[name], t)
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	var drain time.Duration
+	for _, name := range names {
+		d, err := k.connectionDraining(name)
+		if err != nil {
+			return err
+		}
+		if d > drain {
+			drain = d
+		}
+
+		instances := make([]*elb.Instance, len(registered[name]))
+		for i, t := range registered[name] {
+			instances[i] = &elb.Instance{InstanceId: t.InstanceId}
+		}
+		params := &elb.DeregisterInstancesFromLoadBalancerInput{
+			LoadBalancerName: aws.String(name),
+			Instances:        instances,
+		}
+		_, err = k.elbCli.DeregisterInstancesFromLoadBalancer(params)
+		k.audit(auditActionDeregisterInstancesFromLoadBalancer, registered[name], batch, err)
+		if err != nil {
+			return err
+		}
+		for _, t := range registered[name] {
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: aws.StringValue(t.InstanceId),
+				logFieldBatch:      batch,
+				logFieldAction:     auditActionDeregisterInstancesFromLoadBalancer,
+				"elb":              name,
+			}).Info("Deregistered target from ELB")
+		}
+	}
+
+	if drain > 0 {
+		log.WithFields(logrus.Fields{
+			logFieldBatch: batch,
+			"draining":    drain,
+		}).Info("Waiting ELB connection draining")
+		k.sleep(drain)
+	}
+	return nil
+}
+
+// connectionDraining returns the connection draining timeout of the ELB, zero when disabled
+func (k *Killer) connectionDraining(name string) (time.Duration, error) {
+	params := &elb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(name),
+	}
+	resp, err := k.elbCli.DescribeLoadBalancerAttributes(params)
+	if err != nil {
+		return 0, err
+	}
+	if resp.LoadBalancerAttributes == nil || resp.LoadBalancerAttributes.ConnectionDraining == nil {
+		return 0, nil
+	}
+	cd := resp.LoadBalancerAttributes.ConnectionDraining
+	if !aws.BoolValue(cd.Enabled) {
+		return 0, nil
+	}
+	return time.Duration(aws.Int64Value(cd.Timeout)) * time.Second, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestKillerDeregisterFromELBs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockELBCli := sdk.NewMockELBAPI(ctrl)
+
+	// Two batches: i-0,i-1 and i-2,i-3
+	terminatedCalls := []map[string]*ec2.InstanceState{}
+	awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 4, 0)
+	awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+
+	awsMock.MockDescribeLoadBalancersPages(t, mockELBCli, map[string][]string{
+		"elb-a": {"i-0", "i-2", "i-9"},
+		"elb-b": {"i-1"},
+		"elb-c": {"i-9"},
+	})
+	awsMock.MockDescribeLoadBalancerAttributes(t, mockELBCli, map[string]int64{"elb-a": 30})
+	deregistered := map[string][]string{}
+	awsMock.MockDeregisterInstancesFromLoadBalancer(t, mockELBCli, deregistered)
+
+	var sleeps []time.Duration
+	auditor := &testAuditor{}
+	k := &Killer{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        50,
+		auditor:     auditor,
+		ec2Cli:      mockEC2Cli,
+		elbCli:      mockELBCli,
+		sleep: func(d time.Duration) {
+			// The targets of the batch can't be terminated before the draining
+			if len(terminatedCalls) != len(sleeps) {
+				t.Errorf("The batch targets were terminated before waiting the ELB draining")
+			}
+			sleeps = append(sleeps, d)
+		},
+	}
+
+	if err := k.Clean(); err != nil {
+		t.Fatalf("Clean shouldn't give an error: %s", err)
+	}
+
+	wantDeregistered := map[string][]string{
+		"elb-a": {"i-0", "i-2"},
+		"elb-b": {"i-1"},
+	}
+	if !reflect.DeepEqual(deregistered, wantDeregistered) {
+		t.Errorf("Wrong deregistered instances; got: %v, want: %v", deregistered, wantDeregistered)
+	}
+
+	// Only the batches with targets on an ELB with draining wait
+	wantSleeps := []time.Duration{30 * time.Second, 30 * time.Second}
+	if !reflect.DeepEqual(sleeps, wantSleeps) {
+		t.Errorf("Wrong draining waits; got: %v, want: %v", sleeps, wantSleeps)
+	}
+
+	if len(terminatedCalls) != 2 {
+		t.Errorf("Wrong number of calls to terminate instances: got: %d, want: %d", len(terminatedCalls), 2)
+	}
+
+	deregisterRecords := 0
+	for _, r := range auditor.records {
+		if r.Action == auditActionDeregisterInstancesFromLoadBalancer {
+			deregisterRecords++
+		}
+	}
+	if deregisterRecords != 3 {
+		t.Errorf("Wrong number of ELB deregister audit records; got: %d, want: %d", deregisterRecords, 3)
+	}
+}
+
+func TestKillerDeregisterFromELBsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockELBCli := sdk.NewMockELBAPI(ctrl)
+
+	// Terminate isn't expected, the targets are kept when they can't be deregistered
+	awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 2, 0)
+	awsMock.MockDescribeLoadBalancersPages(t, mockELBCli, map[string][]string{"elb-a": {"i-0", "i-1"}})
+	awsMock.MockDescribeLoadBalancerAttributes(t, mockELBCli, map[string]int64{})
+	awsMock.MockDeregisterInstancesFromLoadBalancerError(t, mockELBCli)
+
+	k := &Killer{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        100,
+		ec2Cli:      mockEC2Cli,
+		elbCli:      mockELBCli,
+		sleep:       func(time.Duration) {},
+	}
+
+	if err := k.Clean(); err == nil {
+		t.Errorf("Clean should give an error, it didn't")
+	}
+}
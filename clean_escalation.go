@@ -0,0 +1,391 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// The tags that keep the escalation state of the marked instances
+const (
+	escalationStepTagKey    = "ecs-watcher:escalation-step"
+	escalationStartedTagKey = "ecs-watcher:escalation-started"
+)
+
+// The interval between the rechecks when none of the steps has a timeout
+const escalationRecheckInterval = time.Minute
+
+// escalationStep is a step of the escalation chain
+type escalationStep struct {
+	name string
+
+	// The time the remediation has to fix the instance before rechecking it
+	timeout time.Duration
+
+	remediator Remediator
+}
+
+// remediationChecker is implemented by the remediators that know if their remediation
+// finished on an instance, the unfinished ones aren't healthy on the recheck
+type remediationChecker interface {
+	Remediated(ci *ecs.ContainerInstance) bool
+}
+
+// remediationTracker is implemented by the remediators that start the remediation without
+// waiting it, on the next iterations they return the instances whose remediation already
+// failed so the next step runs without waiting the step timeout
+type remediationTracker interface {
+	Failed(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) ([]*ec2.Instance, error)
+}
+
+// remediationLimiter is implemented by the remediators that limit the instances remediating at
+// the same time, the instances over the limit keep their escalation state until the next iterations
+type remediationLimiter interface {
+	Limit(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) []*ec2.Instance
+}
+
+// EscalationCleaner will run a chain of remediation steps on the marked instances, each
+// step has a timeout to fix the instance, after it the instance is rechecked and if it's
+// still unhealthy the next step runs, the healthy ones are unmarked
+type EscalationCleaner struct {
+	ec2Cli  ec2iface.EC2API
+	ecsCli  ecsiface.ECSAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The tag that marked instnaces to clean have
+	markTag MarkTag
+
+	// The escalation chain
+	steps []*escalationStep
+
+	// The auditor of the escalation actions
+	auditor Auditor
+
+	// The checker that rechecks the remediated instances, by default the agent connected one
+	checker InstanceChecker
+
+	// The verdicts of the last recheck and when it ran
+	lastVerdicts Verdicts
+	lastCheck    time.Time
+}
+
+// NewEscalationCleaner creates a new escalation cleaner
+func NewEscalationCleaner(clusterName string, awsRegion string, mtag string, auditor Auditor, steps ...*escalationStep) (*EscalationCleaner, error) {
+	e := &EscalationCleaner{
+		clusterName: clusterName,
+		steps:       steps,
+		auditor:     auditor,
+	}
+
+	// Set the tag
+	splTag := strings.Split(mtag, ":")
+	e.markTag = MarkTag{splTag[0], splTag[1]}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	e.session = s
+
+	// Create the AWS clients
+	e.ec2Cli = ec2.New(s)
+	e.ecsCli = ecs.New(s)
+
+	return e, nil
+}
+
+// Clean will move the marked instances through the escalation chain
+func (e *EscalationCleaner) Clean() error {
+	log := componentLog(e.clusterName, componentGC)
+
+	instances, err := describeMarkedInstances(e.ec2Cli, e.markTag)
+	if err != nil {
+		return err
+	}
+	if len(instances) == 0 {
+		log.Debug("No targets to remediate")
+		return nil
+	}
+
+	cis, err := describeContainerInstances(e.ecsCli, e.clusterName)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	snapshot := &ClusterSnapshot{
+		Time:               now,
+		ContainerInstances: cis,
+	}
+	registered := snapshot.byInstanceID()
+
+	// Recheck only when a step timed out
+	var verdicts Verdicts
+	for _, i := range instances {
+		if step, started, ok := e.state(i); ok && now.Sub(started) >= e.steps[step].timeout {
+			if verdicts, err = e.recheck(snapshot); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	// The instances that need to run each step and the ones still on their step timeout
+	pending := make([][]*ec2.Instance, len(e.steps))
+	running := make([][]*ec2.Instance, len(e.steps))
+	var healthy []*ec2.Instance
+	for _, i := range instances {
+		step, started, ok := e.state(i)
+		// Not escalated yet, start from the beginning
+		if !ok {
+			pending[0] = append(pending[0], i)
+			continue
+		}
+
+		// Give time to the step
+		if now.Sub(started) < e.steps[step].timeout {
+			running[step] = append(running[step], i)
+			continue
+		}
+
+		// Recheck before the next step, only the registered instances without verdicts are healthy
+		id := aws.StringValue(i.InstanceId)
+		if ci, ok := registered[id]; ok && len(verdicts[id]) == 0 && e.remediated(step, ci) {
+			healthy = append(healthy, i)
+			continue
+		}
+
+		// At the end of the chain keep retrying the last step
+		next := step + 1
+		if next == len(e.steps) {
+			next = step
+		}
+		pending[next] = append(pending[next], i)
+	}
+
+	// A failed remediation doesn't wait the step timeout, the last step waits it to be retried
+	for s := 0; s+1 < len(e.steps); s++ {
+		rt, ok := e.steps[s].remediator.(remediationTracker)
+		if !ok || len(running[s]) == 0 {
+			continue
+		}
+		failed, err := rt.Failed(running[s], registered)
+		if err != nil {
+			return err
+		}
+		pending[s+1] = append(pending[s+1], failed...)
+	}
+
+	if err := e.unmark(healthy); err != nil {
+		return err
+	}
+
+	// The skipped instances of a step are appended to the next one so the steps run in order
+	for s := range pending {
+		if rl, ok := e.steps[s].remediator.(remediationLimiter); ok {
+			pending[s] = rl.Limit(pending[s], registered)
+		}
+		if len(pending[s]) == 0 {
+			continue
+		}
+		skipped, err := e.escalate(s, pending[s])
+		if err != nil {
+			return err
+		}
+		if len(skipped) > 0 && s+1 < len(e.steps) {
+			pending[s+1] = append(pending[s+1], skipped...)
+		}
+	}
+
+	return nil
+}
+
+// recheck returns the verdicts of the configured checkers on the snapshot, they run at most once
+// per the shortest step timeout and the verdicts of the last run are used meanwhile
+func (e *EscalationCleaner) recheck(snapshot *ClusterSnapshot) (Verdicts, error) {
+	if !e.lastCheck.IsZero() && snapshot.Time.Sub(e.lastCheck) < e.recheckInterval() {
+		return e.lastVerdicts, nil
+	}
+	verdicts, err := e.instanceChecker().Unhealthy(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	e.lastVerdicts = verdicts
+	e.lastCheck = snapshot.Time
+	return verdicts, nil
+}
+
+// recheckInterval returns the shortest step timeout
+func (e *EscalationCleaner) recheckInterval() time.Duration {
+	var interval time.Duration
+	for _, s := range e.steps {
+		if s.timeout > 0 && (interval == 0 || s.timeout < interval) {
+			interval = s.timeout
+		}
+	}
+	if interval == 0 {
+		return escalationRecheckInterval
+	}
+	return interval
+}
+
+// instanceChecker returns the checker that rechecks the remediated instances
+func (e *EscalationCleaner) instanceChecker() InstanceChecker {
+	if e.checker == nil {
+		return &AgentConnectedChecker{}
+	}
+	return e.checker
+}
+
+// remediated returns false if the step knows that its remediation didn't finish on the instance
+func (e *EscalationCleaner) remediated(step int, ci *ecs.ContainerInstance) bool {
+	rc, ok := e.steps[step].remediator.(remediationChecker)
+	if !ok {
+		return true
+	}
+	return rc.Remediated(ci)
+}
+
+// state returns the escalation step and when it started of the instance
+func (e *EscalationCleaner) state(i *ec2.Instance) (int, time.Time, bool) {
+	name, ok := instanceTag(i, escalationStepTagKey)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	st, ok := instanceTag(i, escalationStartedTagKey)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	started, err := time.Parse(time.RFC3339, st)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+
+	// If the step isn't on the chain anymore start again
+	for s, step := range e.steps {
+		if step.name == name {
+			return s, started, true
+		}
+	}
+	return 0, time.Time{}, false
+}
+
+// escalate will set the escalation state and run the step on the instances
+func (e *EscalationCleaner) escalate(s int, instances []*ec2.Instance) ([]*ec2.Instance, error) {
+	log := componentLog(e.clusterName, componentGC)
+	step := e.steps[s]
+
+	// Set the state before running the step, so a failed remediation waits for the step timeout
+	// and it's rechecked instead of being retried on each iteration
+	ids := make([]*string, len(instances))
+	for it, i := range instances {
+		ids[it] = i.InstanceId
+	}
+	tags := map[string]string{
+		escalationStepTagKey:    step.name,
+		escalationStartedTagKey: time.Now().UTC().Format(time.RFC3339),
+	}
+	params := &ec2.CreateTagsInput{
+		Resources: ids,
+		Tags: []*ec2.Tag{
+			{Key: aws.String(escalationStepTagKey), Value: aws.String(tags[escalationStepTagKey])},
+			{Key: aws.String(escalationStartedTagKey), Value: aws.String(tags[escalationStartedTagKey])},
+		},
+	}
+	_, err := e.ec2Cli.CreateTags(params)
+	e.audit(auditActionCreateTags, ids, tags, step, err)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: aws.StringValue(id),
+			logFieldAction:     step.name,
+		}).Info("Escalating instance")
+	}
+
+	return step.remediator.Remediate(instances)
+}
+
+// unmark will remove the mark and the escalation state of the fixed instances
+func (e *EscalationCleaner) unmark(instances []*ec2.Instance) error {
+	if len(instances) == 0 {
+		return nil
+	}
+	log := componentLog(e.clusterName, componentGC)
+
+	ids := make([]*string, len(instances))
+	for it, i := range instances {
+		ids[it] = i.InstanceId
+	}
+	params := &ec2.DeleteTagsInput{
+		Resources: ids,
+		Tags: []*ec2.Tag{
+			{Key: aws.String(e.markTag.key), Value: aws.String(e.markTag.value)},
+			{Key: aws.String(escalationStepTagKey)},
+			{Key: aws.String(escalationStartedTagKey)},
+		},
+	}
+	_, err := e.ec2Cli.DeleteTags(params)
+	e.audit(auditActionDeleteTags, ids, map[string]string{e.markTag.key: e.markTag.value}, nil, err)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: aws.StringValue(id),
+			logFieldAction:     auditActionDeleteTags,
+		}).Info("Remediated instance, unmarked")
+	}
+	return nil
+}
+
+// audit will record the escalation actions
+func (e *EscalationCleaner) audit(action string, ids []*string, tags map[string]string, step *escalationStep, actionErr error) {
+	if e.auditor == nil {
+		return
+	}
+
+	thresholds := map[string]string{}
+	for _, s := range e.steps {
+		thresholds[s.name+".timeout"] = s.timeout.String()
+	}
+	if step != nil {
+		thresholds["step"] = step.name
+	}
+
+	records := make([]*AuditRecord, len(ids))
+	for i, id := range ids {
+		r := &AuditRecord{
+			Time:       time.Now().UTC(),
+			Cluster:    e.clusterName,
+			Component:  componentGC,
+			Action:     action,
+			InstanceID: aws.StringValue(id),
+			Tags:       tags,
+			Evidence: AuditEvidence{
+				Thresholds: thresholds,
+			},
+		}
+		if actionErr != nil {
+			r.Error = actionErr.Error()
+		}
+		records[i] = r
+	}
+
+	if err := e.auditor.Audit(records...); err != nil {
+		componentLog(e.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+type testRemediator struct {
+	remediated   []string
+	skip         bool
+	unremediated bool
+	failed       bool
+	deferred     bool
+}
+
+func (t *testRemediator) Limit(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) []*ec2.Instance {
+	if t.deferred {
+		return nil
+	}
+	return instances
+}
+
+func (t *testRemediator) Failed(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) ([]*ec2.Instance, error) {
+	if t.failed {
+		return instances, nil
+	}
+	return nil, nil
+}
+
+func (t *testRemediator) Remediated(ci *ecs.ContainerInstance) bool {
+	return !t.unremediated
+}
+
+func (t *testRemediator) Remediate(instances []*ec2.Instance) ([]*ec2.Instance, error) {
+	for _, i := range instances {
+		t.remediated = append(t.remediated, aws.StringValue(i.InstanceId))
+	}
+	if t.skip {
+		return instances, nil
+	}
+	return nil, nil
+}
+
+func escalatedInstance(id string, step string, started time.Time) *ec2.Instance {
+	i := &ec2.Instance{
+		InstanceId: aws.String(id),
+		Tags: []*ec2.Tag{
+			{Key: aws.String("key"), Value: aws.String("value")},
+		},
+	}
+	if step != "" {
+		i.Tags = append(i.Tags,
+			&ec2.Tag{Key: aws.String(escalationStepTagKey), Value: aws.String(step)},
+			&ec2.Tag{Key: aws.String(escalationStartedTagKey), Value: aws.String(started.Format(time.RFC3339))},
+		)
+	}
+	return i
+}
+
+func TestEscalationCleanerDescribeInstancesError(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Set our mock desired result
+	awsMock.MockDescribeInstancesPagesError(t, mockEC2Cli)
+
+	e := &EscalationCleaner{
+		markTag: MarkTag{"key", "value"},
+		steps:   []*escalationStep{{name: "first", remediator: &testRemediator{}}},
+	}
+	e.ec2Cli = mockEC2Cli
+
+	if err := e.Clean(); err == nil {
+		t.Errorf("Clean should give an error, it didn't")
+	}
+}
+
+func TestEscalationCleanerSteps(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		instance     *ec2.Instance
+		connected    bool
+		flagged      bool
+		skipFirst    bool
+		unremediated bool
+
+		wantFirst    int
+		wantSecond   int
+		wantStep     string
+		wantUnmarked bool
+	}{
+		// New marked instance starts the chain
+		{escalatedInstance("i-0", "", now), false, false, false, false, 1, 0, "first", false},
+		// Step still has time to fix the instance
+		{escalatedInstance("i-0", "first", now.Add(-1*time.Minute)), false, false, false, false, 0, 0, "", false},
+		// Step fixed the instance
+		{escalatedInstance("i-0", "first", now.Add(-10*time.Minute)), true, false, false, false, 0, 0, "", true},
+		// Step didn't fix the instance
+		{escalatedInstance("i-0", "first", now.Add(-10*time.Minute)), false, false, false, false, 0, 1, "second", false},
+		// Last step didn't fix the instance, retry it
+		{escalatedInstance("i-0", "second", now.Add(-10*time.Minute)), false, false, false, false, 0, 1, "second", false},
+		// Unknown step starts the chain again
+		{escalatedInstance("i-0", "unknown", now.Add(-10*time.Minute)), false, false, false, false, 1, 0, "first", false},
+		// The first step can't remediate the instance
+		{escalatedInstance("i-0", "", now), false, false, true, false, 1, 1, "second", false},
+		// The agent is connected but the configured checkers still flag the instance
+		{escalatedInstance("i-0", "first", now.Add(-10*time.Minute)), true, true, false, false, 0, 1, "second", false},
+		// The step knows that its remediation didn't finish, like a failed agent update
+		{escalatedInstance("i-0", "first", now.Add(-10*time.Minute)), true, false, false, true, 0, 1, "second", false},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		// Set our mock desired result
+		tagged := map[string]map[string]string{}
+		untagged := map[string][]string{}
+		awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli, test.instance)
+		awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+		awsMock.MockDeleteTags(t, mockEC2Cli, untagged)
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+		awsMock.MockDescribeContainerInstances(t, mockECSCli, &ecs.ContainerInstance{
+			Ec2InstanceId:  test.instance.InstanceId,
+			AgentConnected: aws.Bool(test.connected),
+		})
+
+		first := &testRemediator{skip: test.skipFirst, unremediated: test.unremediated}
+		second := &testRemediator{}
+		e := &EscalationCleaner{
+			clusterName: "test",
+			markTag:     MarkTag{"key", "value"},
+			steps: []*escalationStep{
+				{name: "first", timeout: 5 * time.Minute, remediator: first},
+				{name: "second", timeout: 5 * time.Minute, remediator: second},
+			},
+		}
+		e.ec2Cli = mockEC2Cli
+		e.ecsCli = mockECSCli
+		if test.flagged {
+			e.checker = &testInstanceChecker{name: "test", unhealthy: []string{"i-0"}}
+		}
+
+		if err := e.Clean(); err != nil {
+			t.Errorf("%+v\n- Clean shouldn't give an error: %s", test, err)
+		}
+
+		if len(first.remediated) != test.wantFirst {
+			t.Errorf("%+v\n- Wrong first step remediations; got: %d, want: %d", test, len(first.remediated), test.wantFirst)
+		}
+		if len(second.remediated) != test.wantSecond {
+			t.Errorf("%+v\n- Wrong second step remediations; got: %d, want: %d", test, len(second.remediated), test.wantSecond)
+		}
+		if got := tagged["i-0"][escalationStepTagKey]; got != test.wantStep {
+			t.Errorf("%+v\n- Wrong escalation step tag; got: %s, want: %s", test, got, test.wantStep)
+		}
+		if _, ok := untagged["i-0"]; ok != test.wantUnmarked {
+			t.Errorf("%+v\n- Wrong unmarking; got: %t, want: %t", test, ok, test.wantUnmarked)
+		}
+	}
+}
+
+func TestEscalationCleanerFailedRemediation(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		step   string
+		failed bool
+
+		wantFirst  int
+		wantSecond int
+	}{
+		// The running remediation didn't fail yet, wait the step timeout
+		{"first", false, 0, 0},
+		// The running remediation failed, the next step doesn't wait the timeout
+		{"first", true, 0, 1},
+		// The last step waits the timeout to be retried
+		{"second", true, 0, 0},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli, escalatedInstance("i-0", test.step, now.Add(-time.Minute)))
+		awsMock.MockCreateTagsAll(t, mockEC2Cli, map[string]map[string]string{})
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+		awsMock.MockDescribeContainerInstances(t, mockECSCli, &ecs.ContainerInstance{
+			Ec2InstanceId:  aws.String("i-0"),
+			AgentConnected: aws.Bool(false),
+		})
+
+		first := &testRemediator{failed: test.failed}
+		second := &testRemediator{failed: test.failed}
+		e := &EscalationCleaner{
+			clusterName: "test",
+			markTag:     MarkTag{"key", "value"},
+			steps: []*escalationStep{
+				{name: "first", timeout: 5 * time.Minute, remediator: first},
+				{name: "second", timeout: 5 * time.Minute, remediator: second},
+			},
+		}
+		e.ec2Cli = mockEC2Cli
+		e.ecsCli = mockECSCli
+
+		if err := e.Clean(); err != nil {
+			t.Errorf("%+v\n- Clean shouldn't give an error: %s", test, err)
+		}
+		if len(first.remediated) != test.wantFirst {
+			t.Errorf("%+v\n- Wrong first step remediations; got: %d, want: %d", test, len(first.remediated), test.wantFirst)
+		}
+		if len(second.remediated) != test.wantSecond {
+			t.Errorf("%+v\n- Wrong second step remediations; got: %d, want: %d", test, len(second.remediated), test.wantSecond)
+		}
+	}
+}
+
+func TestEscalationCleanerLimit(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	// Set our mock desired result
+	tagged := map[string]map[string]string{}
+	awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli, escalatedInstance("i-0", "", time.Time{}))
+	awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+	awsMock.MockDescribeContainerInstances(t, mockECSCli, &ecs.ContainerInstance{Ec2InstanceId: aws.String("i-0")})
+
+	first := &testRemediator{deferred: true}
+	e := &EscalationCleaner{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		steps:       []*escalationStep{{name: "first", timeout: 5 * time.Minute, remediator: first}},
+	}
+	e.ec2Cli = mockEC2Cli
+	e.ecsCli = mockECSCli
+
+	if err := e.Clean(); err != nil {
+		t.Fatalf("Clean shouldn't give an error: %s", err)
+	}
+	// The deferred instances don't start the step
+	if len(first.remediated) != 0 || len(tagged) != 0 {
+		t.Errorf("Deferred instances shouldn't be escalated; got: %d remediated, %d tagged", len(first.remediated), len(tagged))
+	}
+}
+
+type countingChecker struct {
+	calls int
+}
+
+func (c *countingChecker) Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error) {
+	c.calls++
+	return Verdicts{}, nil
+}
+
+func TestEscalationCleanerRecheckInterval(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		started time.Time
+
+		wantCalls int
+	}{
+		// The step has time, nothing to recheck
+		{now.Add(-time.Minute), 0},
+		// The step timed out, rechecked once per step timeout
+		{now.Add(-10 * time.Minute), 1},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		// Set our mock desired result
+		awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli, escalatedInstance("i-0", "first", test.started))
+		awsMock.MockCreateTagsAll(t, mockEC2Cli, map[string]map[string]string{})
+		awsMock.MockDeleteTags(t, mockEC2Cli, map[string][]string{})
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+		awsMock.MockDescribeContainerInstances(t, mockECSCli, &ecs.ContainerInstance{Ec2InstanceId: aws.String("i-0")})
+
+		checker := &countingChecker{}
+		e := &EscalationCleaner{
+			clusterName: "test",
+			markTag:     MarkTag{"key", "value"},
+			steps: []*escalationStep{
+				{name: "first", timeout: 5 * time.Minute, remediator: &testRemediator{}},
+				{name: "second", timeout: 5 * time.Minute, remediator: &testRemediator{}},
+			},
+			checker: checker,
+		}
+		e.ec2Cli = mockEC2Cli
+		e.ecsCli = mockECSCli
+
+		for i := 0; i < 3; i++ {
+			if err := e.Clean(); err != nil {
+				t.Fatalf("%+v\n- Clean shouldn't give an error: %s", test, err)
+			}
+		}
+		if checker.calls != test.wantCalls {
+			t.Errorf("%+v\n- Wrong number of rechecks; got: %d, want: %d", test, checker.calls, test.wantCalls)
+		}
+	}
+}
+
+func TestEscalationChainFlag(t *testing.T) {
+	tests := []struct {
+		value string
+
+		want      string
+		wantError bool
+	}{
+		{"terminate", fmt.Sprintf("terminate:%s", defaultEscalationStepTimeout), false},
+		{"reboot:10m, terminate:1m", "reboot:10m0s,terminate:1m0s", false},
+		{"reboot:10x,terminate", "", true},
+	}
+
+	for _, test := range tests {
+		e := escalationChain{}
+		err := e.Set(test.value)
+		if test.wantError {
+			if err == nil {
+				t.Errorf("%+v\n- Set should give an error, it didn't", test)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v\n- Set shouldn't give an error: %s", test, err)
+		}
+		if e.String() != test.want {
+			t.Errorf("%+v\n- Wrong escalation chain; got: %s, want: %s", test, e.String(), test.want)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// The tags of the forensic EBS snapshots
+const (
+	forensicsClusterTagKey  = "ecs-watcher:cluster"
+	forensicsInstanceTagKey = "ecs-watcher:instance-id"
+	forensicsReasonTagKey   = "ecs-watcher:reason"
+)
+
+// The tag of the captured instances with the capture time, they aren't captured again when the
+// termination of their batch is retried
+const forensicsCapturedTagKey = "ecs-watcher:forensics-captured"
+
+// The files captured for each instance
+const (
+	forensicsConsoleFile           = "console.log"
+	forensicsInstanceFile          = "instance.json"
+	forensicsContainerInstanceFile = "container-instance.json"
+)
+
+// forensicsStore stores the captured files
+type forensicsStore interface {
+	put(key string, data []byte) error
+}
+
+// dirStore stores the captured files on a local directory
+type dirStore struct {
+	dir string
+}
+
+func (d *dirStore) put(key string, data []byte) error {
+	p := filepath.Join(d.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, data, 0640)
+}
+
+// s3Store stores the captured files on a S3 bucket
+type s3Store struct {
+	s3Cli  s3iface.S3API
+	bucket string
+	prefix string
+}
+
+func (s *s3Store) put(key string, data []byte) error {
+	params := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path.Join(s.prefix, key)),
+		Body:   bytes.NewReader(data),
+	}
+	_, err := s.s3Cli.PutObject(params)
+	return err
+}
+
+// Forensics captures the state of the instances before terminating them, so there is something
+// left to debug: the console output, the EBS snapshots of the attached volumes and the EC2 and
+// ECS descriptions of the instances
+type Forensics struct {
+	ec2Cli ec2iface.EC2API
+	ecsCli ecsiface.ECSAPI
+
+	// the name of the cluster
+	clusterName string
+
+	// Where the console output and the descriptions are stored, nil doesn't store them
+	store forensicsStore
+
+	// Should we snapshot the attached EBS volumes?
+	snapshots bool
+
+	// The maximum time of the capture of a batch, after it the batch is terminated
+	timeout time.Duration
+
+	// Should we terminate the targets whose capture failed?
+	bestEffort bool
+}
+
+// NewForensics creates a Forensics that stores the files on the dir directory or on the
+// bucket[/prefix] S3 location, only one of them can be set
+func NewForensics(clusterName string, awsRegion string, dir, s3Location string, snapshots bool, timeout time.Duration, bestEffort bool) (*Forensics, error) {
+	if dir != "" && s3Location != "" {
+		return nil, fmt.Errorf("forensics can't be stored on a directory and S3 at the same time")
+	}
+
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Forensics{
+		ec2Cli:      ec2.New(s),
+		ecsCli:      ecs.New(s),
+		clusterName: clusterName,
+		snapshots:   snapshots,
+		timeout:     timeout,
+		bestEffort:  bestEffort,
+	}
+
+	switch {
+	case dir != "":
+		f.store = &dirStore{dir: dir}
+	case s3Location != "":
+		loc := strings.SplitN(s3Location, "/", 2)
+		st := &s3Store{s3Cli: s3.New(s), bucket: loc[0]}
+		if len(loc) > 1 {
+			st.prefix = loc[1]
+		}
+		f.store = st
+	}
+	return f, nil
+}
+
+// capture captures the instances of a batch that weren't captured yet, it returns when the
+// capture finishes or times out. A failed capture returns an error so the batch isn't
+// terminated and it's retried on the next collection, unless it's best effort; a timed out
+// capture is logged and the batch is terminated without it. The snapshots are tagged with the
+// unhealthy reason tag of the instance, the default reason without it
+func (f *Forensics) capture(targets []*ec2.Instance, batch int, defaultReason string) error {
+	if f == nil {
+		return nil
+	}
+	log := componentLog(f.clusterName, componentGC).WithField(logFieldBatch, batch)
+
+	var pending []*ec2.Instance
+	for _, t := range targets {
+		if _, ok := instanceTag(t, forensicsCapturedTagKey); ok {
+			log.WithField(logFieldInstanceID, aws.StringValue(t.InstanceId)).Debug("Target forensics already captured")
+			continue
+		}
+		pending = append(pending, t)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	done := make(chan int, 1)
+	go func() {
+		done <- f.captureAll(pending, batch, defaultReason)
+	}()
+
+	select {
+	case failed := <-done:
+		if failed == 0 {
+			return nil
+		}
+		if f.bestEffort {
+			log.WithField("failed", failed).Warning("Forensic capture failed, terminating the batch without it")
+			return nil
+		}
+		return fmt.Errorf("forensic capture of %d of %d targets failed", failed, len(pending))
+	case <-time.After(f.timeout):
+		log.WithField("timeout", f.timeout).Warning("Forensic capture timed out, terminating the batch")
+	}
+	return nil
+}
+
+// captureAll captures all the instances and tags the captured ones, the errors are logged and it
+// returns the number of failed ones
+func (f *Forensics) captureAll(targets []*ec2.Instance, batch int, defaultReason string) int {
+	log := componentLog(f.clusterName, componentGC).WithField(logFieldBatch, batch)
+
+	var cis map[string]*ecs.ContainerInstance
+	if f.store != nil {
+		all, err := describeContainerInstances(f.ecsCli, f.clusterName)
+		if err != nil {
+			log.WithError(err).Error("Error describing the container instances, capturing the targets without them")
+		}
+		cis = (&ClusterSnapshot{ContainerInstances: all}).byInstanceID()
+	}
+
+	now := time.Now().UTC()
+	failed := 0
+	for _, t := range targets {
+		id := aws.StringValue(t.InstanceId)
+		l := log.WithField(logFieldInstanceID, id)
+
+		reason, ok := instanceTag(t, unhealthyReasonTagKey)
+		if !ok {
+			reason = defaultReason
+		}
+		if err := f.captureInstance(t, cis[id], reason, now); err != nil {
+			l.WithError(err).Error("Error capturing target forensics")
+			failed++
+			continue
+		}
+
+		params := &ec2.CreateTagsInput{
+			Resources: []*string{t.InstanceId},
+			Tags: []*ec2.Tag{
+				{Key: aws.String(forensicsCapturedTagKey), Value: aws.String(now.Format(time.RFC3339))},
+			},
+		}
+		if _, err := f.ec2Cli.CreateTags(params); err != nil {
+			l.WithError(err).Error("Error tagging the captured target")
+		}
+	}
+	return failed
+}
+
+// captureInstance captures an instance
+func (f *Forensics) captureInstance(i *ec2.Instance, ci *ecs.ContainerInstance, reason string, now time.Time) error {
+	id := aws.StringValue(i.InstanceId)
+	log := componentLog(f.clusterName, componentGC).WithField(logFieldInstanceID, id)
+
+	if f.store != nil {
+		prefix := path.Join(f.clusterName, id, now.Format("20060102T150405Z"))
+
+		resp, err := f.ec2Cli.GetConsoleOutput(&ec2.GetConsoleOutputInput{InstanceId: i.InstanceId})
+		if err != nil {
+			return err
+		}
+		console, err := base64.StdEncoding.DecodeString(aws.StringValue(resp.Output))
+		if err != nil {
+			return fmt.Errorf("wrong console output of %s: %s", id, err)
+		}
+		if err := f.store.put(path.Join(prefix, forensicsConsoleFile), console); err != nil {
+			return err
+		}
+
+		descs := map[string]interface{}{forensicsInstanceFile: i}
+		if ci != nil {
+			descs[forensicsContainerInstanceFile] = ci
+		}
+		for file, d := range descs {
+			b, err := json.MarshalIndent(d, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := f.store.put(path.Join(prefix, file), b); err != nil {
+				return err
+			}
+		}
+		log.WithField("location", prefix).Info("Captured target console output and descriptions")
+	}
+
+	if !f.snapshots {
+		return nil
+	}
+	for _, bd := range i.BlockDeviceMappings {
+		if bd.Ebs == nil || bd.Ebs.VolumeId == nil {
+			continue
+		}
+		params := &ec2.CreateSnapshotInput{
+			VolumeId:    bd.Ebs.VolumeId,
+			Description: aws.String(fmt.Sprintf("ecs-watcher forensics of %s %s on %s", id, aws.StringValue(bd.DeviceName), f.clusterName)),
+		}
+		snap, err := f.ec2Cli.CreateSnapshot(params)
+		if err != nil {
+			return err
+		}
+
+		tparams := &ec2.CreateTagsInput{
+			Resources: []*string{snap.SnapshotId},
+			Tags: []*ec2.Tag{
+				{Key: aws.String(forensicsClusterTagKey), Value: aws.String(f.clusterName)},
+				{Key: aws.String(forensicsInstanceTagKey), Value: aws.String(id)},
+				{Key: aws.String(forensicsReasonTagKey), Value: aws.String(reason)},
+			},
+		}
+		if _, err := f.ec2Cli.CreateTags(tparams); err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"volume_id":   aws.StringValue(bd.Ebs.VolumeId),
+			"snapshot_id": aws.StringValue(snap.SnapshotId),
+		}).Info("Created target volume snapshot")
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func forensicsTestInstances() []*ec2.Instance {
+	return []*ec2.Instance{
+		{
+			InstanceId: aws.String("i-0"),
+			Tags: []*ec2.Tag{
+				{Key: aws.String(unhealthyReasonTagKey), Value: aws.String("agent: ECS agent disconnected")},
+			},
+			BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-0")}},
+				{DeviceName: aws.String("/dev/xvdcz"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-1")}},
+			},
+		},
+		{InstanceId: aws.String("i-1")},
+		// Captured on a previous try
+		{
+			InstanceId: aws.String("i-2"),
+			Tags: []*ec2.Tag{
+				{Key: aws.String(forensicsCapturedTagKey), Value: aws.String("2017-01-10T10:00:00Z")},
+			},
+			BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-2")}},
+			},
+		},
+	}
+}
+
+func TestForensicsCaptureDir(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	dir, err := ioutil.TempDir("", "ecs-watcher-forensics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+	awsMock.MockDescribeContainerInstances(t, mockECSCli, &ecs.ContainerInstance{Ec2InstanceId: aws.String("i-0")})
+	awsMock.MockGetConsoleOutput(t, mockEC2Cli, map[string]string{"i-0": "docker: no space left on device"})
+	var volumes []string
+	awsMock.MockCreateSnapshot(t, mockEC2Cli, &volumes)
+	tagged := map[string]map[string]string{}
+	awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+
+	f := &Forensics{
+		ec2Cli:      mockEC2Cli,
+		ecsCli:      mockECSCli,
+		clusterName: "test",
+		store:       &dirStore{dir: dir},
+		snapshots:   true,
+		timeout:     time.Minute,
+	}
+
+	f.capture(forensicsTestInstances(), 1, "marked unhealthy")
+
+	var files []string
+	filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files = append(files, p)
+		}
+		return nil
+	})
+	sort.Strings(files)
+	var got []string
+	for _, p := range files {
+		// cluster/instance/time/file
+		parts := strings.Split(strings.TrimPrefix(p, dir+string(filepath.Separator)), string(filepath.Separator))
+		got = append(got, filepath.Join(parts[0], parts[1], parts[3]))
+		if parts[1] == "i-0" && parts[3] == forensicsConsoleFile {
+			if b, _ := ioutil.ReadFile(p); string(b) != "docker: no space left on device" {
+				t.Errorf("Wrong console output; got: %q", b)
+			}
+		}
+	}
+	want := []string{
+		"test/i-0/console.log", "test/i-0/container-instance.json", "test/i-0/instance.json",
+		"test/i-1/console.log", "test/i-1/instance.json",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong captured files; got: %v, want: %v", got, want)
+	}
+
+	if want := []string{"vol-0", "vol-1"}; !reflect.DeepEqual(volumes, want) {
+		t.Errorf("Wrong snapshotted volumes; got: %v, want: %v", volumes, want)
+	}
+	wantTags := map[string]string{
+		forensicsClusterTagKey:  "test",
+		forensicsInstanceTagKey: "i-0",
+		forensicsReasonTagKey:   "agent: ECS agent disconnected",
+	}
+	if !reflect.DeepEqual(tagged["snap-vol-1"], wantTags) {
+		t.Errorf("Wrong snapshot tags; got: %v, want: %v", tagged["snap-vol-1"], wantTags)
+	}
+	for _, id := range []string{"i-0", "i-1"} {
+		if _, ok := tagged[id][forensicsCapturedTagKey]; !ok {
+			t.Errorf("The captured target %s should be tagged, it isn't", id)
+		}
+	}
+	if _, ok := tagged["i-2"]; ok {
+		t.Errorf("The already captured target shouldn't be tagged again, it is")
+	}
+}
+
+func TestForensicsCaptureS3(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+	mockS3Cli := sdk.NewMockS3API(ctrl)
+
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 0)
+	awsMock.MockGetConsoleOutput(t, mockEC2Cli, map[string]string{"i-1": "kernel panic"})
+	objects := map[string]string{}
+	awsMock.MockPutObject(t, mockS3Cli, objects)
+	tagged := map[string]map[string]string{}
+	awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+
+	f := &Forensics{
+		ec2Cli:      mockEC2Cli,
+		ecsCli:      mockECSCli,
+		clusterName: "test",
+		store:       &s3Store{s3Cli: mockS3Cli, bucket: "bucket", prefix: "forensics"},
+		timeout:     time.Minute,
+	}
+
+	f.capture(forensicsTestInstances()[1:2], 1, "marked unhealthy")
+
+	if len(objects) != 2 {
+		t.Errorf("Wrong number of stored objects; got: %d, want: %d", len(objects), 2)
+	}
+	for k, v := range objects {
+		if !strings.HasPrefix(k, "bucket/forensics/test/i-1/") {
+			t.Errorf("Wrong object location; got: %s", k)
+		}
+		if strings.HasSuffix(k, forensicsConsoleFile) && v != "kernel panic" {
+			t.Errorf("Wrong console output; got: %q", v)
+		}
+	}
+}
+
+func TestKillerForensicsError(t *testing.T) {
+	tests := []struct {
+		bestEffort bool
+		wantCalls  int
+		wantError  bool
+	}{
+		// The batch stays marked to retry the capture on the next collection
+		{false, 0, true},
+		// The batch is terminated without the capture
+		{true, 1, false},
+	}
+
+	for _, test := range tests {
+		ctrl := gomock.NewController(t)
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		// The capture fails, the failed targets aren't tagged
+		awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 2, 0)
+		awsMock.MockListContainerInstancesPagesError(t, mockECSCli)
+		awsMock.MockGetConsoleOutputError(t, mockEC2Cli)
+		terminatedCalls := []map[string]*ec2.InstanceState{}
+		awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+
+		k := &Killer{
+			clusterName: "test",
+			markTag:     MarkTag{"key", "value"},
+			step:        100,
+			ec2Cli:      mockEC2Cli,
+			forensics: &Forensics{
+				ec2Cli:      mockEC2Cli,
+				ecsCli:      mockECSCli,
+				clusterName: "test",
+				store:       &dirStore{dir: os.TempDir()},
+				timeout:     time.Minute,
+				bestEffort:  test.bestEffort,
+			},
+		}
+
+		err := k.Clean()
+		ctrl.Finish()
+		if test.wantError != (err != nil) {
+			t.Errorf("%+v\n- Wrong Clean error with a failed capture; got: %v", test, err)
+		}
+		if len(terminatedCalls) != test.wantCalls {
+			t.Errorf("%+v\n- Wrong number of calls to terminate instances; got: %d, want: %d", test, len(terminatedCalls), test.wantCalls)
+		}
+		if test.wantCalls > 0 && len(terminatedCalls[0]) != 2 {
+			t.Errorf("%+v\n- The whole batch should be terminated; got: %v", test, terminatedCalls)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// Kill hook phases
+const (
+	hookPhasePre  = "pre"
+	hookPhasePost = "post"
+)
+
+// The outcomes of a batch sent to the post kill hook
+const (
+	hookOutcomeTerminated = "terminated"
+	hookOutcomeFailed     = "failed"
+	hookOutcomeVetoed     = "vetoed"
+	hookOutcomeDelayed    = "delayed"
+	hookOutcomeAborted    = "aborted"
+)
+
+// The pre kill hook exit code to delay the batch instead of vetoing it, EX_TEMPFAIL
+const hookExitDelay = 75
+
+// hookDelayOutput is the optional JSON output of a pre kill hook that delays the batch
+type hookDelayOutput struct {
+	RetryAfter string `json:"retry_after"`
+}
+
+// hookDelayedError is returned when the pre kill hook delays the batch
+type hookDelayedError struct {
+	retryAfter time.Duration
+}
+
+func (e *hookDelayedError) Error() string {
+	return fmt.Sprintf("pre kill hook delayed the batch %s", e.retryAfter)
+}
+
+// hookInstance is the metadata of a batch instance sent to the hooks
+type hookInstance struct {
+	InstanceID   string            `json:"instance_id"`
+	InstanceType string            `json:"instance_type,omitempty"`
+	PrivateIP    string            `json:"private_ip,omitempty"`
+	LaunchTime   *time.Time        `json:"launch_time,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// hookInput is the batch description sent to the hooks on stdin
+type hookInput struct {
+	Cluster   string          `json:"cluster"`
+	Phase     string          `json:"phase"`
+	Batch     int             `json:"batch"`
+	Instances []*hookInstance `json:"instances"`
+	Outcome   string          `json:"outcome,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// KillHooks are the commands run around each batch of terminated instances. The pre kill hook
+// can veto the batch exiting with a non zero code or delay it exiting with hookExitDelay, the
+// post kill hook receives the outcome
+type KillHooks struct {
+	// the name of the cluster
+	clusterName string
+
+	// The commands and their arguments, an empty one isn't run
+	pre  []string
+	post []string
+
+	// The maximum time a hook can run
+	timeout time.Duration
+
+	// The time after a veto or a delay without retry after before running the pre kill hook again
+	retry time.Duration
+
+	// The pre kill hook isn't run again until this time
+	retryAt time.Time
+}
+
+// NewKillHooks creates the kill hooks, it returns nil when there aren't hooks
+func NewKillHooks(clusterName string, pre, post []string, timeout, retry time.Duration) *KillHooks {
+	if len(pre) == 0 && len(post) == 0 {
+		return nil
+	}
+	return &KillHooks{
+		clusterName: clusterName,
+		pre:         pre,
+		post:        post,
+		timeout:     timeout,
+		retry:       retry,
+	}
+}
+
+// retryIn returns the time until the pre kill hook can run again after a veto or a delay
+func (h *KillHooks) retryIn() time.Duration {
+	if h == nil {
+		return 0
+	}
+	if d := h.retryAt.Sub(time.Now()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// preKill runs the pre kill hook, it returns an error when the batch is vetoed, a hook that
+// fails or times out also vetoes the batch, and a hookDelayedError when it's delayed. The hook
+// isn't run again until the retry after of the delay or the retry of the veto passes
+func (h *KillHooks) preKill(batch int, targets []*ec2.Instance) error {
+	if h == nil || len(h.pre) == 0 {
+		return nil
+	}
+	res, err := h.run(h.pre, h.input(hookPhasePre, batch, targets))
+	if err != nil {
+		h.retryAt = time.Now().Add(h.retry)
+		return err
+	}
+	switch res.code {
+	case 0:
+		return nil
+	case hookExitDelay:
+		d := h.retry
+		out := &hookDelayOutput{}
+		if err := json.Unmarshal([]byte(res.stdout), out); err == nil && out.RetryAfter != "" {
+			if ra, err := time.ParseDuration(out.RetryAfter); err == nil && ra > 0 {
+				d = ra
+			}
+		}
+		h.retryAt = time.Now().Add(d)
+		return &hookDelayedError{retryAfter: d}
+	}
+	h.retryAt = time.Now().Add(h.retry)
+	return fmt.Errorf("pre kill hook exited with %d", res.code)
+}
+
+// postKill runs the post kill hook with the outcome of the batch, its failures are only logged
+func (h *KillHooks) postKill(batch int, targets []*ec2.Instance, outcome string, batchErr error) {
+	if h == nil || len(h.post) == 0 {
+		return
+	}
+	in := h.input(hookPhasePost, batch, targets)
+	in.Outcome = outcome
+	if batchErr != nil {
+		in.Error = batchErr.Error()
+	}
+
+	log := componentLog(h.clusterName, componentGC).WithFields(logrus.Fields{
+		logFieldBatch: batch,
+		"hook":        hookPhasePost,
+	})
+	res, err := h.run(h.post, in)
+	if err != nil {
+		log.WithError(err).Error("Error running kill hook")
+		return
+	}
+	if res.code != 0 {
+		log.WithField("exit_code", res.code).Error("Kill hook failed")
+	}
+}
+
+// run runs the hook command and logs its output
+func (h *KillHooks) run(command []string, in *hookInput) (*commandResult, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	log := componentLog(h.clusterName, componentGC).WithFields(logrus.Fields{
+		logFieldBatch: in.Batch,
+		"hook":        in.Phase,
+	})
+	log.Debug("Running kill hook")
+	res, err := runCommand(command, h.timeout, b)
+	if err != nil {
+		return nil, err
+	}
+	for _, out := range []struct {
+		stream string
+		text   string
+	}{{"stdout", res.stdout}, {"stderr", res.stderr}} {
+		for _, l := range strings.Split(strings.TrimSpace(out.text), "\n") {
+			if l != "" {
+				log.WithField("stream", out.stream).Info(l)
+			}
+		}
+	}
+	return res, nil
+}
+
+// input returns the description of the batch for the hooks
+func (h *KillHooks) input(phase string, batch int, targets []*ec2.Instance) *hookInput {
+	in := &hookInput{
+		Cluster:   h.clusterName,
+		Phase:     phase,
+		Batch:     batch,
+		Instances: make([]*hookInstance, len(targets)),
+	}
+	for i, t := range targets {
+		hi := &hookInstance{
+			InstanceID:   aws.StringValue(t.InstanceId),
+			InstanceType: aws.StringValue(t.InstanceType),
+			PrivateIP:    aws.StringValue(t.PrivateIpAddress),
+			LaunchTime:   t.LaunchTime,
+			Tags:         map[string]string{},
+		}
+		for _, tag := range t.Tags {
+			hi.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		in.Instances[i] = hi
+	}
+	return in
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestKillerHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	dir, err := ioutil.TempDir("", "ecs-watcher-hooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	postOut := filepath.Join(dir, "post")
+
+	// Two batches: i-0,i-1 and i-2,i-3
+	terminatedCalls := []map[string]*ec2.InstanceState{}
+	awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 4, 0)
+	awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+
+	k := &Killer{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        50,
+		ec2Cli:      mockEC2Cli,
+		hooks: NewKillHooks("test",
+			[]string{"sh", "-c", `case "$(cat)" in *'"i-3"'*) echo "deployment in progress"; exit 1;; esac`},
+			[]string{"sh", "-c", "cat >> " + postOut + "; echo >> " + postOut},
+			time.Second, time.Hour),
+	}
+
+	if err := k.Clean(); err == nil {
+		t.Errorf("Clean should give an error with a vetoed batch, it didn't")
+	}
+
+	// The hook isn't asked again until the retry
+	if err := k.Clean(); err != nil {
+		t.Errorf("Clean shouldn't give an error waiting the hook retry, it did: %s", err)
+	}
+
+	if len(terminatedCalls) != 1 {
+		t.Fatalf("Wrong number of calls to terminate instances: got: %d, want: %d", len(terminatedCalls), 1)
+	}
+	if _, ok := terminatedCalls[0]["i-0"]; !ok {
+		t.Errorf("The batch before the vetoed one should be terminated; got: %v", terminatedCalls[0])
+	}
+
+	b, err := ioutil.ReadFile(postOut)
+	if err != nil {
+		t.Fatalf("Post kill hook didn't run: %s", err)
+	}
+	var outcomes []string
+	for _, l := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		in := &hookInput{}
+		if err := json.Unmarshal([]byte(l), in); err != nil {
+			t.Fatalf("Wrong post kill hook input %q: %s", l, err)
+		}
+		if in.Phase != hookPhasePost || len(in.Instances) != 2 {
+			t.Errorf("Wrong post kill hook input; got: %+v", in)
+		}
+		outcomes = append(outcomes, in.Outcome)
+	}
+	if want := []string{hookOutcomeTerminated, hookOutcomeVetoed}; strings.Join(outcomes, ",") != strings.Join(want, ",") {
+		t.Errorf("Wrong post kill hook outcomes; got: %v, want: %v", outcomes, want)
+	}
+}
+
+func TestKillerPreHookTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Terminate isn't expected, a timed out hook vetoes the batch
+	awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 2, 0)
+
+	k := &Killer{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        100,
+		ec2Cli:      mockEC2Cli,
+		hooks:       NewKillHooks("test", []string{"sh", "-c", "sleep 5; true"}, nil, 100*time.Millisecond, time.Hour),
+	}
+
+	if err := k.Clean(); err == nil {
+		t.Errorf("Clean should give an error with a vetoed batch, it didn't")
+	}
+}
+
+func TestKillerPreHookDelay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Terminate isn't expected, the delay stops the run without error
+	awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 4, 0)
+
+	k := &Killer{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        50,
+		ec2Cli:      mockEC2Cli,
+		hooks: NewKillHooks("test",
+			[]string{"sh", "-c", `cat >/dev/null; echo '{"retry_after":"2h"}'; exit 75`}, nil,
+			time.Second, time.Hour),
+	}
+
+	if err := k.Clean(); err != nil {
+		t.Errorf("Clean shouldn't give an error with a delayed batch, it did: %s", err)
+	}
+	if d := k.hooks.retryIn(); d <= time.Hour || d > 2*time.Hour {
+		t.Errorf("The retry after of the delay should be used; got: %s, want: %s", d, 2*time.Hour)
+	}
+}
@@ -0,0 +1,501 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+const (
+	// The tag that has when the instance was quarantined
+	quarantinedTagKey = "ecs-watcher:quarantined"
+
+	// The maximum instances of a DescribeAutoScalingInstances call
+	describeAutoScalingInstancesMax = 50
+)
+
+// Quarantiner will isolate the marked instances instead of terminating them, so they can be
+// investigated. The instances are detached from their autoscaling group (so it replaces them),
+// moved to the quarantine security group, deregistered from the cluster and stopped. Each
+// collection quarantines a batch once the autoscaling groups of the batch have their desired
+// capacity in service, so the previous batches are replaced. The quarantined instances are
+// terminated after the retention
+type Quarantiner struct {
+	ec2Cli  ec2iface.EC2API
+	ecsCli  ecsiface.ECSAPI
+	asgCli  autoscalingiface.AutoScalingAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The tag that marked instnaces to clean have
+	markTag MarkTag
+
+	// The percent of the marked instances quarantined on each batch
+	step int
+
+	// The security group that replaces the ones of the quarantined instances
+	group string
+
+	// The time the instances are quarantined before terminating them, 0 keeps them forever
+	retention time.Duration
+
+	// The maximum time waiting for the replacements of the last batch, 0 doesn't wait
+	replaceTimeout time.Duration
+
+	// The auditor of the quarantine actions
+	auditor Auditor
+}
+
+// NewQuarantiner creates a new quarantiner
+func NewQuarantiner(clusterName string, awsRegion string, stepPercent int, mtag string, group string, retention, replaceTimeout time.Duration, auditor Auditor) (*Quarantiner, error) {
+	if group == "" {
+		return nil, fmt.Errorf("quarantine cleaner needs a quarantine security group")
+	}
+
+	q := &Quarantiner{
+		clusterName:    clusterName,
+		step:           stepPercent,
+		group:          group,
+		retention:      retention,
+		replaceTimeout: replaceTimeout,
+		auditor:        auditor,
+	}
+
+	// Set the tag
+	splTag := strings.Split(mtag, ":")
+	q.markTag = MarkTag{splTag[0], splTag[1]}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	q.session = s
+
+	// Create the AWS clients
+	q.ec2Cli = ec2.New(s)
+	q.ecsCli = ecs.New(s)
+	q.asgCli = autoscaling.New(s)
+
+	return q, nil
+}
+
+// Clean will quarantine a batch of the marked instances and terminate the expired quarantined ones
+func (q *Quarantiner) Clean() error {
+	log := componentLog(q.clusterName, componentGC)
+
+	instances, err := describeMarkedInstances(q.ec2Cli, q.markTag)
+	if err != nil {
+		return err
+	}
+	quarantined, err := q.describeQuarantined()
+	if err != nil {
+		return err
+	}
+	if err := q.unmarkStopped(quarantined); err != nil {
+		return err
+	}
+	if len(instances) == 0 {
+		log.Debug("No targets to quarantine")
+	} else if err := q.quarantine(instances, quarantined); err != nil {
+		return err
+	}
+
+	return q.expire(quarantined)
+}
+
+// unmarkStopped will remove the mark of the quarantined instances that were stopped but kept it,
+// the marked instances query only returns the running ones so they wouldn't be retried
+func (q *Quarantiner) unmarkStopped(quarantined []*ec2.Instance) error {
+	log := componentLog(q.clusterName, componentGC)
+
+	failed := 0
+	for _, i := range quarantined {
+		if _, ok := instanceTag(i, q.markTag.key); !ok || i.State == nil {
+			continue
+		}
+		state := aws.StringValue(i.State.Name)
+		if state != ec2.InstanceStateNameStopping && state != ec2.InstanceStateNameStopped {
+			continue
+		}
+
+		params := &ec2.DeleteTagsInput{
+			Resources: []*string{i.InstanceId},
+			Tags:      []*ec2.Tag{{Key: aws.String(q.markTag.key)}},
+		}
+		_, err := q.ec2Cli.DeleteTags(params)
+		q.audit(auditActionDeleteTags, i, err)
+		l := log.WithField(logFieldInstanceID, aws.StringValue(i.InstanceId))
+		if err != nil {
+			l.WithError(err).Error("Error unmarking quarantined target")
+			failed++
+			continue
+		}
+		l.Info("Unmarked stopped quarantined target")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d stopped quarantined targets failed to unmark", failed)
+	}
+	return nil
+}
+
+// quarantine will quarantine a batch of the instances, the partially quarantined ones go first.
+// The batch waits while its autoscaling groups are short of instances in service, if they are
+// still short after the replace timeout since the last quarantine it gives an error. A failed
+// instance doesn't stop the others
+func (q *Quarantiner) quarantine(instances []*ec2.Instance, quarantined []*ec2.Instance) error {
+	log := componentLog(q.clusterName, componentGC)
+
+	// Get the number of instances per step
+	n := q.step * len(instances) / 100
+	if n == 0 {
+		n = 1
+	}
+
+	// The retries finish the batch that started them
+	ordered := make([]*ec2.Instance, 0, len(instances))
+	var fresh []*ec2.Instance
+	for _, i := range instances {
+		if _, ok := instanceTag(i, quarantinedTagKey); ok {
+			ordered = append(ordered, i)
+		} else {
+			fresh = append(fresh, i)
+		}
+	}
+	ordered = append(ordered, fresh...)
+	batch := ordered[:n]
+
+	groups, err := instanceAutoScalingGroups(q.asgCli, batch)
+	if err != nil {
+		return err
+	}
+
+	// Don't leave the groups short of more instances until they replace the previous ones
+	short, err := q.shortGroups(groups)
+	if err != nil {
+		return err
+	}
+	if len(short) > 0 {
+		l := log.WithFields(logrus.Fields{
+			"asgs":      strings.Join(short, ","),
+			"remaining": len(instances),
+		})
+		if time.Since(lastQuarantine(quarantined)) < q.replaceTimeout {
+			l.Info("Waiting quarantined targets replacements")
+			return nil
+		}
+		l.Error("Autoscaling groups not replaced, the targets stay marked")
+		return fmt.Errorf("autoscaling groups %s without their desired capacity in service after %s", strings.Join(short, ","), q.replaceTimeout)
+	}
+
+	all, err := describeContainerInstances(q.ecsCli, q.clusterName)
+	if err != nil {
+		return err
+	}
+	cis := (&ClusterSnapshot{ContainerInstances: all}).byInstanceID()
+
+	failed := 0
+	for _, t := range batch {
+		id := aws.StringValue(t.InstanceId)
+		l := log.WithField(logFieldInstanceID, id)
+		if err := q.quarantineInstance(t, groups[id], cis[id]); err != nil {
+			l.WithError(err).Error("Error quarantining target")
+			failed++
+			continue
+		}
+		l.Info("Quarantined target")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d targets failed to quarantine", failed, len(batch))
+	}
+	return nil
+}
+
+// shortGroups returns the autoscaling groups of the instances that don't have their desired
+// capacity in service, none if the replacements aren't waited
+func (q *Quarantiner) shortGroups(instanceGroups map[string]string) ([]string, error) {
+	if q.replaceTimeout == 0 {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	var names []*string
+	for _, g := range instanceGroups {
+		if g != "" && !seen[g] {
+			seen[g] = true
+			names = append(names, aws.String(g))
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	params := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: names,
+	}
+	var short []string
+	err := q.asgCli.DescribeAutoScalingGroupsPages(params,
+		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			for _, g := range page.AutoScalingGroups {
+				var inService int64
+				for _, i := range g.Instances {
+					if aws.StringValue(i.LifecycleState) == autoscaling.LifecycleStateInService {
+						inService++
+					}
+				}
+				if inService < aws.Int64Value(g.DesiredCapacity) {
+					short = append(short, aws.StringValue(g.AutoScalingGroupName))
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	return short, nil
+}
+
+// lastQuarantine returns the latest quarantine time of the instances, zero if there isn't one
+func lastQuarantine(instances []*ec2.Instance) time.Time {
+	var last time.Time
+	for _, i := range instances {
+		v, _ := instanceTag(i, quarantinedTagKey)
+		if t, err := time.Parse(time.RFC3339, v); err == nil && t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+// quarantineInstance will isolate an instance, each step is retried on the next collection
+// until the mark is removed
+func (q *Quarantiner) quarantineInstance(i *ec2.Instance, group string, ci *ecs.ContainerInstance) error {
+	id := i.InstanceId
+
+	// Keep the first quarantine time on retries
+	if _, ok := instanceTag(i, quarantinedTagKey); !ok {
+		params := &ec2.CreateTagsInput{
+			Resources: []*string{id},
+			Tags: []*ec2.Tag{
+				{Key: aws.String(quarantinedTagKey), Value: aws.String(time.Now().UTC().Format(time.RFC3339))},
+			},
+		}
+		_, err := q.ec2Cli.CreateTags(params)
+		q.audit(auditActionCreateTags, i, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The group launches a replacement
+	if group != "" {
+		params := &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           aws.String(group),
+			InstanceIds:                    []*string{id},
+			ShouldDecrementDesiredCapacity: aws.Bool(false),
+		}
+		_, err := q.asgCli.DetachInstances(params)
+		q.audit(auditActionDetachInstances, i, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	mparams := &ec2.ModifyInstanceAttributeInput{
+		InstanceId: id,
+		Groups:     []*string{aws.String(q.group)},
+	}
+	_, err := q.ec2Cli.ModifyInstanceAttribute(mparams)
+	q.audit(auditActionModifyInstanceAttribute, i, err)
+	if err != nil {
+		return err
+	}
+
+	if ci != nil {
+		params := &ecs.DeregisterContainerInstanceInput{
+			Cluster:           aws.String(q.clusterName),
+			ContainerInstance: ci.ContainerInstanceArn,
+			Force:             aws.Bool(true),
+		}
+		_, err := q.ecsCli.DeregisterContainerInstance(params)
+		q.audit(auditActionDeregisterContainerInstance, i, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = q.ec2Cli.StopInstances(&ec2.StopInstancesInput{InstanceIds: []*string{id}})
+	q.audit(auditActionStopInstances, i, err)
+	if err != nil {
+		return err
+	}
+
+	// Done, the other cleaners don't need to handle it
+	dparams := &ec2.DeleteTagsInput{
+		Resources: []*string{id},
+		Tags:      []*ec2.Tag{{Key: aws.String(q.markTag.key)}},
+	}
+	_, err = q.ec2Cli.DeleteTags(dparams)
+	q.audit(auditActionDeleteTags, i, err)
+	return err
+}
+
+// instanceAutoScalingGroups returns the autoscaling group of the instances that are on one
+func instanceAutoScalingGroups(cli autoscalingiface.AutoScalingAPI, instances []*ec2.Instance) (map[string]string, error) {
+	groups := map[string]string{}
+	for i := 0; i < len(instances); i = i + describeAutoScalingInstancesMax {
+		end := i + describeAutoScalingInstancesMax
+		if end > len(instances) {
+			end = len(instances)
+		}
+		ids := make([]*string, 0, end-i)
+		for _, ins := range instances[i:end] {
+			ids = append(ids, ins.InstanceId)
+		}
+
+		params := &autoscaling.DescribeAutoScalingInstancesInput{
+			InstanceIds: ids,
+		}
+		err := cli.DescribeAutoScalingInstancesPages(params,
+			func(page *autoscaling.DescribeAutoScalingInstancesOutput, lastPage bool) bool {
+				for _, asi := range page.AutoScalingInstances {
+					groups[aws.StringValue(asi.InstanceId)] = aws.StringValue(asi.AutoScalingGroupName)
+				}
+				return true
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// describeQuarantined returns the quarantined instances that aren't terminated
+func (q *Quarantiner) describeQuarantined() ([]*ec2.Instance, error) {
+	params := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag-key"),
+				Values: []*string{aws.String(quarantinedTagKey)},
+			},
+			{
+				Name: aws.String("instance-state-name"),
+				Values: []*string{
+					aws.String(ec2.InstanceStateNamePending),
+					aws.String(ec2.InstanceStateNameRunning),
+					aws.String(ec2.InstanceStateNameStopping),
+					aws.String(ec2.InstanceStateNameStopped),
+				},
+			},
+		},
+	}
+	var instances []*ec2.Instance
+	err := q.ec2Cli.DescribeInstancesPages(params,
+		func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, r := range page.Reservations {
+				instances = append(instances, r.Instances...)
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// expire will terminate the instances quarantined for more than the retention
+func (q *Quarantiner) expire(quarantined []*ec2.Instance) error {
+	if q.retention == 0 {
+		return nil
+	}
+	log := componentLog(q.clusterName, componentGC)
+
+	var expired []*ec2.Instance
+	now := time.Now().UTC()
+	for _, i := range quarantined {
+		v, _ := instanceTag(i, quarantinedTagKey)
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: aws.StringValue(i.InstanceId),
+				"tag":              v,
+			}).Warning("Wrong quarantined tag")
+			continue
+		}
+		if now.Sub(t) >= q.retention {
+			expired = append(expired, i)
+		}
+	}
+	if len(expired) == 0 {
+		log.Debug("No expired quarantined targets")
+		return nil
+	}
+
+	ids := make([]*string, len(expired))
+	for it, i := range expired {
+		ids[it] = i.InstanceId
+	}
+	_, err := q.ec2Cli.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: ids})
+	for _, i := range expired {
+		q.audit(auditActionTerminateInstances, i, err)
+	}
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: aws.StringValue(id),
+			logFieldAction:     auditActionTerminateInstances,
+		}).Info("Terminated expired quarantined target")
+	}
+	return nil
+}
+
+// audit will record a quarantine action of an instance
+func (q *Quarantiner) audit(action string, i *ec2.Instance, actionErr error) {
+	if q.auditor == nil {
+		return
+	}
+
+	r := &AuditRecord{
+		Time:       time.Now().UTC(),
+		Cluster:    q.clusterName,
+		Component:  componentGC,
+		Action:     action,
+		InstanceID: aws.StringValue(i.InstanceId),
+		Tags:       map[string]string{},
+		Evidence: AuditEvidence{
+			Thresholds: map[string]string{
+				"gc.step.percent":            strconv.Itoa(q.step),
+				"quarantine.group":           q.group,
+				"quarantine.retention":       q.retention.String(),
+				"quarantine.replace.timeout": q.replaceTimeout.String(),
+			},
+		},
+	}
+	for _, t := range i.Tags {
+		r.Tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	if actionErr != nil {
+		r.Error = actionErr.Error()
+	}
+
+	if err := q.auditor.Audit(r); err != nil {
+		componentLog(q.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
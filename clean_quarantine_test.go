@@ -0,0 +1,249 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestQuarantiner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+	mockASGCli := sdk.NewMockAutoScalingAPI(ctrl)
+
+	quarantined := func(id string, ago time.Duration) *ec2.Instance {
+		return &ec2.Instance{
+			InstanceId: aws.String(id),
+			Tags: []*ec2.Tag{
+				{Key: aws.String(quarantinedTagKey), Value: aws.String(time.Now().UTC().Add(-ago).Format(time.RFC3339))},
+			},
+		}
+	}
+
+	// i-7 was stopped but its mark wasn't removed
+	stoppedMarked := quarantined("i-7", time.Hour)
+	stoppedMarked.State = &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameStopped)}
+	stoppedMarked.Tags = append(stoppedMarked.Tags, &ec2.Tag{Key: aws.String("key"), Value: aws.String("value")})
+
+	// i-1 was partially quarantined on a previous collection
+	awsMock.MockDescribeInstancesPagesByFilter(t, mockEC2Cli, map[string][]*ec2.Instance{
+		"tag:key": {{InstanceId: aws.String("i-0")}, quarantined("i-1", time.Hour)},
+		"tag-key": {quarantined("i-8", 10*24*time.Hour), quarantined("i-9", 24*time.Hour), stoppedMarked},
+	})
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+	awsMock.MockDescribeContainerInstances(t, mockECSCli, &ecs.ContainerInstance{
+		Ec2InstanceId:        aws.String("i-0"),
+		ContainerInstanceArn: aws.String("arn-0"),
+	})
+	awsMock.MockDescribeAutoScalingInstancesPages(t, mockASGCli, map[string]string{"i-0": "asg-0"})
+
+	tagged := map[string]map[string]string{}
+	awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+	detached := map[string][]string{}
+	awsMock.MockDetachInstances(t, mockASGCli, detached)
+	groups := map[string][]string{}
+	awsMock.MockModifyInstanceAttribute(t, mockEC2Cli, groups)
+	var deregistered []string
+	awsMock.MockDeregisterContainerInstance(t, mockECSCli, &deregistered)
+	var stopped []string
+	awsMock.MockStopInstances(t, mockEC2Cli, &stopped)
+	untagged := map[string][]string{}
+	awsMock.MockDeleteTags(t, mockEC2Cli, untagged)
+	terminatedCalls := []map[string]*ec2.InstanceState{}
+	awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+
+	auditor := &testAuditor{}
+	q := &Quarantiner{
+		ec2Cli:      mockEC2Cli,
+		ecsCli:      mockECSCli,
+		asgCli:      mockASGCli,
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        100,
+		group:       "sg-q",
+		retention:   7 * 24 * time.Hour,
+		auditor:     auditor,
+	}
+
+	if err := q.Clean(); err != nil {
+		t.Fatalf("Clean shouldn't give an error: %s", err)
+	}
+
+	if _, ok := tagged["i-0"][quarantinedTagKey]; !ok || len(tagged) != 1 {
+		t.Errorf("Only i-0 should be tagged as quarantined; got: %v", tagged)
+	}
+	if want := map[string][]string{"asg-0": {"i-0"}}; !reflect.DeepEqual(detached, want) {
+		t.Errorf("Wrong detached instances; got: %v, want: %v", detached, want)
+	}
+	if want := map[string][]string{"i-0": {"sg-q"}, "i-1": {"sg-q"}}; !reflect.DeepEqual(groups, want) {
+		t.Errorf("Wrong security groups; got: %v, want: %v", groups, want)
+	}
+	if want := []string{"arn-0"}; !reflect.DeepEqual(deregistered, want) {
+		t.Errorf("Wrong deregistered container instances; got: %v, want: %v", deregistered, want)
+	}
+	// The partially quarantined ones go first
+	if want := []string{"i-1", "i-0"}; !reflect.DeepEqual(stopped, want) {
+		t.Errorf("Wrong stopped instances; got: %v, want: %v", stopped, want)
+	}
+	if want := map[string][]string{"i-0": {"key"}, "i-1": {"key"}, "i-7": {"key"}}; !reflect.DeepEqual(untagged, want) {
+		t.Errorf("Wrong unmarked instances; got: %v, want: %v", untagged, want)
+	}
+
+	// Only the expired ones
+	if len(terminatedCalls) != 1 || len(terminatedCalls[0]) != 1 {
+		t.Fatalf("Wrong terminated instances; got: %v", terminatedCalls)
+	}
+	if _, ok := terminatedCalls[0]["i-8"]; !ok {
+		t.Errorf("i-8 should be terminated; got: %v", terminatedCalls[0])
+	}
+
+	actions := map[string]int{}
+	for _, r := range auditor.records {
+		actions[r.Action]++
+	}
+	wantActions := map[string]int{
+		auditActionCreateTags:                  1,
+		auditActionDetachInstances:             1,
+		auditActionModifyInstanceAttribute:     2,
+		auditActionDeregisterContainerInstance: 1,
+		auditActionStopInstances:               2,
+		auditActionDeleteTags:                  3,
+		auditActionTerminateInstances:          1,
+	}
+	if !reflect.DeepEqual(actions, wantActions) {
+		t.Errorf("Wrong audited actions; got: %v, want: %v", actions, wantActions)
+	}
+}
+
+func TestQuarantinerKeepForever(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Without marked instances and retention nothing is called but the instances queries
+	awsMock.MockDescribeInstancesPagesByFilter(t, mockEC2Cli, map[string][]*ec2.Instance{})
+
+	q := &Quarantiner{
+		ec2Cli:      mockEC2Cli,
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		group:       "sg-q",
+	}
+
+	if err := q.Clean(); err != nil {
+		t.Errorf("Clean shouldn't give an error: %s", err)
+	}
+}
+
+func TestQuarantinerBatches(t *testing.T) {
+	// asg-0 has 4 desired instances, the replacement of a batch goes through pending first
+	asg := func(inService int) []*autoscaling.Group {
+		g := &autoscaling.Group{
+			AutoScalingGroupName: aws.String("asg-0"),
+			DesiredCapacity:      aws.Int64(4),
+		}
+		for i := 0; i < 4; i++ {
+			state := autoscaling.LifecycleStateInService
+			if i >= inService {
+				state = autoscaling.LifecycleStatePending
+			}
+			g.Instances = append(g.Instances, &autoscaling.Instance{
+				InstanceId:     aws.String(fmt.Sprintf("i-new-%d", i)),
+				LifecycleState: aws.String(state),
+			})
+		}
+		return []*autoscaling.Group{g}
+	}
+	quarantined := func(id string, ago time.Duration) *ec2.Instance {
+		return &ec2.Instance{
+			InstanceId: aws.String(id),
+			Tags: []*ec2.Tag{
+				{Key: aws.String(quarantinedTagKey), Value: aws.String(time.Now().UTC().Add(-ago).Format(time.RFC3339))},
+			},
+		}
+	}
+
+	tests := []struct {
+		groups      []*autoscaling.Group
+		quarantined []*ec2.Instance
+		wantStopped []string
+		wantErr     bool
+	}{
+		// The groups are complete, a batch is quarantined
+		{asg(4), nil, []string{"i-0", "i-1"}, false},
+		// The replacements of the previous batch are arriving, wait them
+		{asg(3), []*ec2.Instance{quarantined("i-8", time.Minute)}, nil, false},
+		// The replacements never arrived
+		{asg(3), []*ec2.Instance{quarantined("i-8", time.Hour)}, nil, true},
+		// The groups are short without a previous batch
+		{asg(3), nil, nil, true},
+	}
+
+	for it, test := range tests {
+		ctrl := gomock.NewController(t)
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+		mockASGCli := sdk.NewMockAutoScalingAPI(ctrl)
+
+		var marked []*ec2.Instance
+		inGroup := map[string]string{}
+		for i := 0; i < 4; i++ {
+			id := fmt.Sprintf("i-%d", i)
+			marked = append(marked, &ec2.Instance{InstanceId: aws.String(id)})
+			inGroup[id] = "asg-0"
+		}
+		awsMock.MockDescribeInstancesPagesByFilter(t, mockEC2Cli, map[string][]*ec2.Instance{
+			"tag:key": marked,
+			"tag-key": test.quarantined,
+		})
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 0)
+		awsMock.MockDescribeAutoScalingInstancesPages(t, mockASGCli, inGroup)
+		calls := 0
+		awsMock.MockDescribeAutoScalingGroupsPagesSequence(t, mockASGCli, &calls, test.groups)
+
+		awsMock.MockCreateTagsAll(t, mockEC2Cli, map[string]map[string]string{})
+		awsMock.MockDetachInstances(t, mockASGCli, map[string][]string{})
+		awsMock.MockModifyInstanceAttribute(t, mockEC2Cli, map[string][]string{})
+		var stopped []string
+		awsMock.MockStopInstances(t, mockEC2Cli, &stopped)
+		awsMock.MockDeleteTags(t, mockEC2Cli, map[string][]string{})
+
+		q := &Quarantiner{
+			ec2Cli:         mockEC2Cli,
+			ecsCli:         mockECSCli,
+			asgCli:         mockASGCli,
+			clusterName:    "test",
+			markTag:        MarkTag{"key", "value"},
+			step:           50,
+			group:          "sg-q",
+			replaceTimeout: 15 * time.Minute,
+		}
+
+		err := q.Clean()
+		if test.wantErr && err == nil {
+			t.Errorf("%d: Clean should give an error, it didn't", it)
+		}
+		if !test.wantErr && err != nil {
+			t.Errorf("%d: Clean shouldn't give an error, it did: %s", it, err)
+		}
+		if !reflect.DeepEqual(stopped, test.wantStopped) {
+			t.Errorf("%d: Wrong stopped instances; got: %v, want: %v", it, stopped, test.wantStopped)
+		}
+		if calls != 1 {
+			t.Errorf("%d: The replacements should be checked once; got: %d calls", it, calls)
+		}
+		ctrl.Finish()
+	}
+}
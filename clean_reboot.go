@@ -0,0 +1,189 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// rebootAttemptsTagKey is the tag that keeps the reboots of an instance on the current period
+// as count,period start; the size of the value doesn't grow with the reboots, EC2 tag values
+// are limited to 256 characters
+const rebootAttemptsTagKey = "ecs-watcher:reboots"
+
+// rebootAttempts are the reboots of an instance since the period started
+type rebootAttempts struct {
+	count   int
+	started time.Time
+}
+
+// String returns the tag value of the attempts
+func (r rebootAttempts) String() string {
+	return strconv.Itoa(r.count) + "," + r.started.Format(time.RFC3339)
+}
+
+// Rebooter will reboot the marked instances, most of the disconnected agents are fixed
+// with a reboot so this is cheaper than terminating them
+type Rebooter struct {
+	ec2Cli  ec2iface.EC2API
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The maximum reboots of an instance in the period before handing it to the next step
+	maxReboots int
+	period     time.Duration
+
+	// The auditor of the rebooting actions
+	auditor Auditor
+}
+
+// NewRebooter creates a new rebooter
+func NewRebooter(clusterName string, awsRegion string, maxReboots int, period time.Duration, auditor Auditor) (*Rebooter, error) {
+	r := &Rebooter{
+		clusterName: clusterName,
+		maxReboots:  maxReboots,
+		period:      period,
+		auditor:     auditor,
+	}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	r.session = s
+
+	// Create the AWS EC2 client
+	r.ec2Cli = ec2.New(s)
+
+	return r, nil
+}
+
+// Remediate will reboot the instances and record the attempt on each of them, the instances
+// that were rebooted too many times on the period are skipped
+func (r *Rebooter) Remediate(instances []*ec2.Instance) ([]*ec2.Instance, error) {
+	log := componentLog(r.clusterName, componentGC)
+	now := time.Now().UTC()
+
+	var skipped []*ec2.Instance
+	var targets []*ec2.Instance
+	attempts := map[string]rebootAttempts{}
+	for _, i := range instances {
+		id := aws.StringValue(i.InstanceId)
+		a := r.attempts(i, now)
+		if a.count >= r.maxReboots {
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: id,
+				"reboots":          a.count,
+			}).Info("Too many reboots, skipping reboot")
+			skipped = append(skipped, i)
+			continue
+		}
+		a.count++
+		attempts[id] = a
+		targets = append(targets, i)
+	}
+
+	if len(targets) == 0 {
+		return skipped, nil
+	}
+
+	ids := make([]*string, len(targets))
+	for it, t := range targets {
+		ids[it] = t.InstanceId
+	}
+	_, err := r.ec2Cli.RebootInstances(&ec2.RebootInstancesInput{InstanceIds: ids})
+	r.audit(auditActionRebootInstances, ids, nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the attempts, each instance has its own reboot history
+	for _, id := range ids {
+		v := attempts[aws.StringValue(id)].String()
+		params := &ec2.CreateTagsInput{
+			Resources: []*string{id},
+			Tags: []*ec2.Tag{
+				{Key: aws.String(rebootAttemptsTagKey), Value: aws.String(v)},
+			},
+		}
+		_, err := r.ec2Cli.CreateTags(params)
+		r.audit(auditActionCreateTags, []*string{id}, map[string]string{rebootAttemptsTagKey: v}, err)
+		if err != nil {
+			return nil, err
+		}
+
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: aws.StringValue(id),
+			logFieldAction:     auditActionRebootInstances,
+			"reboots":          attempts[aws.StringValue(id)].count,
+		}).Info("Rebooted instance")
+	}
+
+	return skipped, nil
+}
+
+// attempts returns the reboot attempts of the instance on the period, when the period of the
+// recorded attempts is over or the tag is malformed a new one starts now
+func (r *Rebooter) attempts(i *ec2.Instance, now time.Time) rebootAttempts {
+	current := rebootAttempts{started: now}
+	v, ok := instanceTag(i, rebootAttemptsTagKey)
+	if !ok || v == "" {
+		return current
+	}
+
+	fields := strings.Split(v, ",")
+	if len(fields) != 2 {
+		return current
+	}
+	count, err := strconv.Atoi(fields[0])
+	if err != nil || count < 0 {
+		return current
+	}
+	started, err := time.Parse(time.RFC3339, fields[1])
+	if err != nil || now.Sub(started) > r.period {
+		return current
+	}
+	return rebootAttempts{count: count, started: started}
+}
+
+// audit will record the rebooting actions
+func (r *Rebooter) audit(action string, ids []*string, tags map[string]string, actionErr error) {
+	if r.auditor == nil {
+		return
+	}
+
+	records := make([]*AuditRecord, len(ids))
+	for i, id := range ids {
+		rec := &AuditRecord{
+			Time:       time.Now().UTC(),
+			Cluster:    r.clusterName,
+			Component:  componentGC,
+			Action:     action,
+			InstanceID: aws.StringValue(id),
+			Tags:       tags,
+			Evidence: AuditEvidence{
+				Thresholds: map[string]string{
+					"reboot.max":    strconv.Itoa(r.maxReboots),
+					"reboot.period": r.period.String(),
+				},
+			},
+		}
+		if actionErr != nil {
+			rec.Error = actionErr.Error()
+		}
+		records[i] = rec
+	}
+
+	if err := r.auditor.Audit(records...); err != nil {
+		componentLog(r.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
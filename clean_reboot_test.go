@@ -0,0 +1,118 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func rebootedInstance(id string, reboots int, started time.Time) *ec2.Instance {
+	i := &ec2.Instance{InstanceId: aws.String(id)}
+	if reboots > 0 {
+		i.Tags = []*ec2.Tag{
+			{Key: aws.String(rebootAttemptsTagKey), Value: aws.String(rebootAttempts{reboots, started}.String())},
+		}
+	}
+	return i
+}
+
+func rebootTaggedInstance(id string, tag string) *ec2.Instance {
+	return &ec2.Instance{
+		InstanceId: aws.String(id),
+		Tags:       []*ec2.Tag{{Key: aws.String(rebootAttemptsTagKey), Value: aws.String(tag)}},
+	}
+}
+
+func TestRebooterRebootError(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Set our mock desired result
+	awsMock.MockRebootInstancesError(t, mockEC2Cli)
+
+	r := &Rebooter{
+		maxReboots: 3,
+		period:     time.Hour,
+	}
+	r.ec2Cli = mockEC2Cli
+
+	if _, err := r.Remediate([]*ec2.Instance{rebootedInstance("i-0", 0, time.Time{})}); err == nil {
+		t.Errorf("Remediate should give an error, it didn't")
+	}
+}
+
+func TestRebooterRemediate(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		instance *ec2.Instance
+
+		wantRebooted bool
+		wantReboots  int
+		wantStarted  time.Time
+	}{
+		{rebootedInstance("i-0", 0, time.Time{}), true, 1, now},
+		{rebootedInstance("i-0", 1, now.Add(-10*time.Minute)), true, 2, now.Add(-10 * time.Minute)},
+		{rebootedInstance("i-0", 2, now.Add(-20*time.Minute)), false, 0, time.Time{}},
+		// The reboots of an old period aren't counted, a new period starts
+		{rebootedInstance("i-0", 2, now.Add(-2*time.Hour)), true, 1, now},
+		// Many reboots don't grow the tag
+		{rebootedInstance("i-0", 500, now.Add(-2*time.Hour)), true, 1, now},
+		// A malformed tag has no reboots
+		{rebootTaggedInstance("i-0", now.Add(-10*time.Minute).Format(time.RFC3339)), true, 1, now},
+		{rebootTaggedInstance("i-0", "two,"+now.Add(-10*time.Minute).Format(time.RFC3339)), true, 1, now},
+		{rebootTaggedInstance("i-0", "-1,"+now.Add(-10*time.Minute).Format(time.RFC3339)), true, 1, now},
+	}
+
+	for _, test := range tests {
+		// Create mock for AWS API
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+		// Set our mock desired result
+		rebooted := []string{}
+		tagged := map[string]map[string]string{}
+		awsMock.MockRebootInstances(t, mockEC2Cli, &rebooted)
+		awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+
+		r := &Rebooter{
+			clusterName: "test",
+			maxReboots:  2,
+			period:      time.Hour,
+		}
+		r.ec2Cli = mockEC2Cli
+
+		skipped, err := r.Remediate([]*ec2.Instance{test.instance})
+		if err != nil {
+			t.Errorf("%+v\n- Remediate shouldn't give an error: %s", test, err)
+		}
+
+		if (len(rebooted) == 1) != test.wantRebooted {
+			t.Errorf("%+v\n- Wrong reboot; got: %v, want: %t", test, rebooted, test.wantRebooted)
+		}
+		if (len(skipped) == 0) != test.wantRebooted {
+			t.Errorf("%+v\n- Wrong skipped instances; got: %d", test, len(skipped))
+		}
+
+		if test.wantRebooted {
+			got := r.attempts(&ec2.Instance{Tags: []*ec2.Tag{
+				{Key: aws.String(rebootAttemptsTagKey), Value: aws.String(tagged["i-0"][rebootAttemptsTagKey])},
+			}}, now)
+			if got.count != test.wantReboots {
+				t.Errorf("%+v\n- Wrong reboot attempts; got: %d, want: %d", test, got.count, test.wantReboots)
+			}
+			// The tag keeps the time with second precision
+			if d := got.started.Sub(test.wantStarted); d > time.Second || d < -time.Second {
+				t.Errorf("%+v\n- Wrong reboot period start; got: %s, want: %s", test, got.started, test.wantStarted)
+			}
+		}
+	}
+}
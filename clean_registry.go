@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Cleaner names
+const (
+	killerCleanerName     = "killer"
+	escalationCleanerName = "escalation"
+	rebootCleanerName     = "reboot"
+	restartCleanerName    = "restart-agent"
+	updateCleanerName     = "update-agent"
+	replaceCleanerName    = "replace"
+	staleCleanerName      = "stale"
+	quarantineCleanerName = "quarantine"
+)
+
+// Remediation step names
+const (
+	terminateRemediatorName = "terminate"
+	rebootRemediatorName    = "reboot"
+	restartRemediatorName   = "restart-agent"
+	updateRemediatorName    = "update-agent"
+)
+
+// cleanerFactory creates a cleaner from the configuration
+type cleanerFactory func(cfg Config, auditor Auditor) (Cleaner, error)
+
+// cleanerRegistry has the available cleaners by name
+var cleanerRegistry = map[string]cleanerFactory{
+	killerCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		return newKiller(cfg, auditor)
+	},
+	escalationCleanerName: newEscalationCleaner,
+	// Reboot the instances and terminate them if they aren't fixed on the reboot window
+	rebootCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		cfg.gcEscalation = escalationChain{
+			{name: rebootRemediatorName, timeout: cfg.rebootWindow},
+			{name: terminateRemediatorName},
+		}
+		return newEscalationCleaner(cfg, auditor)
+	},
+	// Restart the agent and terminate the instances if they aren't fixed on the restart window
+	restartCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		cfg.gcEscalation = escalationChain{
+			{name: restartRemediatorName, timeout: cfg.ssmWindow},
+			{name: terminateRemediatorName},
+		}
+		return newEscalationCleaner(cfg, auditor)
+	},
+	// Update the agent, the instances aren't terminated, a failed update is retried
+	updateCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		cfg.gcEscalation = escalationChain{
+			{name: updateRemediatorName, timeout: cfg.agentUpdateTimeout},
+		}
+		return newEscalationCleaner(cfg, auditor)
+	},
+	// Drain the instances and terminate them when they don't have live tasks
+	replaceCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		f, err := newForensics(cfg)
+		if err != nil {
+			return nil, err
+		}
+		s, err := newSurge(cfg)
+		if err != nil {
+			return nil, err
+		}
+		c, err := newCapacityGate(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewReplacer(cfg.clusterName, cfg.awsRegion, cfg.gcStepPercent, cfg.unhealthyTag, cfg.drainTimeout, cfg.gcELBDeregister, newKillHooks(cfg), f, s, c, auditor)
+	},
+	// Deregister the container instances whose EC2 instance is gone, it doesn't use the marked instances
+	staleCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		return NewStaleCleaner(cfg.clusterName, cfg.awsRegion, cfg.gcStepPercent, cfg.staleInterval, cfg.staleDryRun, auditor)
+	},
+	// Isolate the instances instead of terminating them, they are terminated after the retention
+	quarantineCleanerName: func(cfg Config, auditor Auditor) (Cleaner, error) {
+		return NewQuarantiner(cfg.clusterName, cfg.awsRegion, cfg.gcStepPercent, cfg.unhealthyTag, cfg.quarantineGroup, cfg.quarantineRetention, cfg.quarantineReplace, auditor)
+	},
+}
+
+// remediatorFactory creates an escalation step remediator from the configuration
+type remediatorFactory func(cfg Config, auditor Auditor) (Remediator, error)
+
+// remediatorRegistry has the available escalation steps by name
+var remediatorRegistry = map[string]remediatorFactory{
+	terminateRemediatorName: func(cfg Config, auditor Auditor) (Remediator, error) {
+		return newKiller(cfg, auditor)
+	},
+	rebootRemediatorName: func(cfg Config, auditor Auditor) (Remediator, error) {
+		return NewRebooter(cfg.clusterName, cfg.awsRegion, cfg.rebootMax, cfg.rebootPeriod, auditor)
+	},
+	restartRemediatorName: func(cfg Config, auditor Auditor) (Remediator, error) {
+		return NewAgentRestarter(cfg.clusterName, cfg.awsRegion, cfg.ssmDocument, cfg.ssmCommands, cfg.ssmTimeout, auditor)
+	},
+	updateRemediatorName: func(cfg Config, auditor Auditor) (Remediator, error) {
+		return NewAgentUpdater(cfg.clusterName, cfg.awsRegion, cfg.agentUpdateBatch, cfg.agentUpdateTimeout, auditor)
+	},
+}
+
+// newKiller creates a killer with the configured kill hooks, forensics, surge and capacity gate
+func newKiller(cfg Config, auditor Auditor) (*Killer, error) {
+	f, err := newForensics(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := newSurge(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := newCapacityGate(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewKiller(cfg.clusterName, cfg.awsRegion, cfg.gcStepPercent, cfg.unhealthyTag, cfg.gcELBDeregister, newKillHooks(cfg), f, s, c, auditor)
+}
+
+// newCapacityGate creates the configured capacity gate, nil without gate
+func newCapacityGate(cfg Config) (*CapacityGate, error) {
+	if cfg.gcCapacityTimeout == 0 {
+		return nil, nil
+	}
+	return NewCapacityGate(cfg.clusterName, cfg.awsRegion, cfg.gcCapacityTimeout, cfg.gcCapacityExpiry, cfg.gcCapacityNamespace)
+}
+
+// newSurge creates the configured surge, nil without surge
+func newSurge(cfg Config) (*Surge, error) {
+	if !cfg.gcSurge {
+		return nil, nil
+	}
+	return NewSurge(cfg.clusterName, cfg.awsRegion, cfg.gcSurgeTimeout)
+}
+
+// newForensics creates the configured forensics, nil when there isn't anything to capture
+func newForensics(cfg Config) (*Forensics, error) {
+	if cfg.forensicsDir == "" && cfg.forensicsS3 == "" && !cfg.forensicsSnapshots {
+		return nil, nil
+	}
+	return NewForensics(cfg.clusterName, cfg.awsRegion, cfg.forensicsDir, cfg.forensicsS3, cfg.forensicsSnapshots, cfg.forensicsTimeout, cfg.forensicsBestEffort)
+}
+
+// newKillHooks creates the configured kill hooks
+func newKillHooks(cfg Config) *KillHooks {
+	return NewKillHooks(cfg.clusterName, strings.Fields(cfg.gcHookPre), strings.Fields(cfg.gcHookPost), cfg.gcHookTimeout, cfg.gcHookRetry)
+}
+
+// cleanerNames returns the names of the registered cleaners
+func cleanerNames() []string {
+	names := make([]string, 0, len(cleanerRegistry))
+	for n := range cleanerRegistry {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// remediatorNames returns the names of the registered escalation steps
+func remediatorNames() []string {
+	names := make([]string, 0, len(remediatorRegistry))
+	for n := range remediatorRegistry {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newCleaner creates the configured cleaners, when more than one are selected they
+// are run in order by a composite cleaner
+func newCleaner(cfg Config, auditor Auditor) (Cleaner, error) {
+	cleaners := make([]Cleaner, len(cfg.gcCleaners))
+	for i, name := range cfg.gcCleaners {
+		f, ok := cleanerRegistry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown cleaner: %s", name)
+		}
+		c, err := f(cfg, auditor)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s cleaner: %s", name, err)
+		}
+		cleaners[i] = c
+	}
+
+	if len(cleaners) == 1 {
+		return cleaners[0], nil
+	}
+	return NewCompositeCleaner(cfg.clusterName, cleaners...), nil
+}
+
+// newEscalationCleaner creates an escalation cleaner with the configured chain
+func newEscalationCleaner(cfg Config, auditor Auditor) (Cleaner, error) {
+	steps := make([]*escalationStep, len(cfg.gcEscalation))
+	for i, sc := range cfg.gcEscalation {
+		f, ok := remediatorRegistry[sc.name]
+		if !ok {
+			return nil, fmt.Errorf("unknown escalation step: %s", sc.name)
+		}
+		r, err := f(cfg, auditor)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s escalation step: %s", sc.name, err)
+		}
+		steps[i] = &escalationStep{
+			name:       sc.name,
+			timeout:    sc.timeout,
+			remediator: r,
+		}
+	}
+	e, err := NewEscalationCleaner(cfg.clusterName, cfg.awsRegion, cfg.unhealthyTag, auditor, steps...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remediated instances are rechecked with the same checkers that marked them
+	ic, err := newInstanceChecker(cfg, e.session)
+	if err != nil {
+		return nil, err
+	}
+	e.checker = ic
+	return e, nil
+}
+
+// escalationStepConfig is the configuration of an escalation step
+type escalationStepConfig struct {
+	name    string
+	timeout time.Duration
+}
+
+// escalationChain is a comma separated list flag of name[:timeout] escalation steps
+type escalationChain []escalationStepConfig
+
+func (e *escalationChain) String() string {
+	steps := make([]string, len(*e))
+	for i, s := range *e {
+		steps[i] = fmt.Sprintf("%s:%s", s.name, s.timeout)
+	}
+	return strings.Join(steps, ",")
+}
+
+func (e *escalationChain) Set(v string) error {
+	*e = escalationChain{}
+	for _, step := range strings.Split(v, ",") {
+		step = strings.TrimSpace(step)
+		if step == "" {
+			continue
+		}
+
+		sc := escalationStepConfig{name: step, timeout: defaultEscalationStepTimeout}
+		if i := strings.Index(step, ":"); i >= 0 {
+			t, err := time.ParseDuration(step[i+1:])
+			if err != nil {
+				return fmt.Errorf("wrong %s escalation step timeout: %s", step, err)
+			}
+			sc.name = step[:i]
+			sc.timeout = t
+		}
+		*e = append(*e, sc)
+	}
+	return nil
+}
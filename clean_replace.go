@@ -0,0 +1,343 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+const (
+	// The tag that has when the drain of a marked instance started
+	drainStartedTagKey = "ecs-watcher:drain-started"
+
+	// The maximum container instances of an UpdateContainerInstancesState call
+	updateContainerInstancesStateMax = 10
+
+	// The time before a scheduled event when the draining instances are terminated even
+	// with live tasks, so they are replaced before AWS takes them out
+	drainEventDeadlineMargin = 15 * time.Minute
+)
+
+// Replacer will replace the marked instances gracefully, the ECS DRAINING state moves the
+// service tasks to the rest of the cluster and when the instances don't have live tasks they
+// are terminated in batches
+type Replacer struct {
+	ec2Cli  ec2iface.EC2API
+	ecsCli  ecsiface.ECSAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The tag that marked instnaces to clean have
+	markTag MarkTag
+
+	// The time an instance can be draining before terminating it with its live tasks, 0 waits until
+	// it doesn't have live tasks
+	drainTimeout time.Duration
+
+	// The killer that terminates the drained instances, its step is also the drain batch size
+	killer *Killer
+
+	// The auditor of the draining actions
+	auditor Auditor
+}
+
+// NewReplacer creates a new replacer
+func NewReplacer(clusterName string, awsRegion string, stepPercent int, mtag string, drainTimeout time.Duration, elbDeregister bool, hooks *KillHooks, forensics *Forensics, surge *Surge, capacity *CapacityGate, auditor Auditor) (*Replacer, error) {
+	r := &Replacer{
+		clusterName:  clusterName,
+		drainTimeout: drainTimeout,
+		auditor:      auditor,
+	}
+
+	// Set the tag
+	splTag := strings.Split(mtag, ":")
+	r.markTag = MarkTag{splTag[0], splTag[1]}
+
+	k, err := NewKiller(clusterName, awsRegion, stepPercent, mtag, elbDeregister, hooks, forensics, surge, capacity, auditor)
+	if err != nil {
+		return nil, err
+	}
+	r.killer = k
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	r.session = s
+
+	// Create the AWS clients
+	r.ec2Cli = ec2.New(s)
+	r.ecsCli = ecs.New(s)
+
+	return r, nil
+}
+
+// Clean will drain the marked instances in batches and terminate the drained ones, an instance
+// with live tasks is only terminated when its agent is disconnected, its drain timed out or its
+// scheduled event deadline is close
+func (r *Replacer) Clean() error {
+	log := componentLog(r.clusterName, componentGC)
+
+	instances, err := describeMarkedInstances(r.ec2Cli, r.markTag)
+	if err != nil {
+		return err
+	}
+	if len(instances) == 0 {
+		log.Debug("No targets to replace")
+		return nil
+	}
+
+	cis, err := describeContainerInstances(r.ecsCli, r.clusterName)
+	if err != nil {
+		return err
+	}
+	byID := (&ClusterSnapshot{ContainerInstances: cis}).byInstanceID()
+
+	deadlines, err := r.eventDeadlines(instances)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	var drained, draining, active []*ec2.Instance
+	for _, i := range instances {
+		id := aws.StringValue(i.InstanceId)
+		ci, ok := byID[id]
+		l := log.WithField(logFieldInstanceID, id)
+
+		// Not registered on the cluster, nothing to drain
+		if !ok {
+			drained = append(drained, i)
+			continue
+		}
+
+		live := aws.Int64Value(ci.RunningTasksCount) + aws.Int64Value(ci.PendingTasksCount)
+		isDraining := aws.StringValue(ci.Status) == ecs.ContainerInstanceStatusDraining
+
+		// Drained, ECS doesn't place tasks on the draining instances so it stays empty
+		if isDraining && live == 0 {
+			drained = append(drained, i)
+			continue
+		}
+
+		// A disconnected agent doesn't stop its tasks, it would never be drained
+		if !aws.BoolValue(ci.AgentConnected) {
+			l.WithField("live", live).Warning("Agent disconnected, replacing target without draining")
+			drained = append(drained, i)
+			continue
+		}
+
+		// AWS is going to take it out anyway, replace it while we can
+		if d, ok := deadlines[id]; ok && !now.Before(d.Add(-drainEventDeadlineMargin)) {
+			l.WithFields(logrus.Fields{
+				"live":       live,
+				"not_before": d.Format(time.RFC3339),
+			}).Warning("Scheduled event deadline, replacing target with live tasks")
+			drained = append(drained, i)
+			continue
+		}
+
+		if !isDraining {
+			active = append(active, i)
+			continue
+		}
+
+		if started, ok := r.drainStarted(i); ok && r.drainTimeout > 0 && now.Sub(started) >= r.drainTimeout {
+			l.WithFields(logrus.Fields{
+				"live":    live,
+				"started": started.Format(time.RFC3339),
+			}).Warning("Drain timeout, replacing target with live tasks")
+			drained = append(drained, i)
+			continue
+		}
+		draining = append(draining, i)
+	}
+
+	// Drain in batches, the next instances start draining when the draining and the drained
+	// ones are replaced
+	n := r.killer.step * len(instances) / 100
+	if n == 0 {
+		n = 1
+	}
+	start := n - len(draining) - len(drained)
+	if start < 0 {
+		start = 0
+	}
+	if start > len(active) {
+		start = len(active)
+	}
+	if err := r.drain(active[:start], byID); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"draining": len(draining) + start,
+		"waiting":  len(active) - start,
+	}).Debug("Draining targets")
+
+	if len(drained) == 0 {
+		log.Debug("No drained targets to kill")
+		return nil
+	}
+	return r.killer.kill(drained)
+}
+
+// drainStarted returns when the drain of the instance started
+func (r *Replacer) drainStarted(i *ec2.Instance) (time.Time, bool) {
+	st, ok := instanceTag(i, drainStartedTagKey)
+	if !ok {
+		return time.Time{}, false
+	}
+	started, err := time.Parse(time.RFC3339, st)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return started, true
+}
+
+// eventDeadlines returns the earliest not before time of the scheduled events of the instances
+func (r *Replacer) eventDeadlines(instances []*ec2.Instance) (map[string]time.Time, error) {
+	ids := make([]*string, len(instances))
+	for it, i := range instances {
+		ids[it] = i.InstanceId
+	}
+
+	deadlines := map[string]time.Time{}
+	params := &ec2.DescribeInstanceStatusInput{
+		InstanceIds: ids,
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("event.code"),
+				Values: aws.StringSlice(ec2ScheduledEventCodes),
+			},
+		},
+	}
+	err := r.ec2Cli.DescribeInstanceStatusPages(params,
+		func(page *ec2.DescribeInstanceStatusOutput, lastPage bool) bool {
+			for _, st := range page.InstanceStatuses {
+				id := aws.StringValue(st.InstanceId)
+				for _, ev := range st.Events {
+					if !ec2EventScheduled(ev) || ev.NotBefore == nil {
+						continue
+					}
+					nb := aws.TimeValue(ev.NotBefore).UTC()
+					if d, ok := deadlines[id]; !ok || nb.Before(d) {
+						deadlines[id] = nb
+					}
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	return deadlines, nil
+}
+
+// drain will set the instances on the DRAINING state, ECS stops placing tasks on them and
+// replaces their service tasks on the rest of the cluster respecting the service deployment
+// configuration, the other tasks are left to finish by themselves
+func (r *Replacer) drain(instances []*ec2.Instance, byID map[string]*ecs.ContainerInstance) error {
+	if len(instances) == 0 {
+		return nil
+	}
+	log := componentLog(r.clusterName, componentGC)
+
+	// Set when the drain started, an instance set to active again outside keeps its start
+	var ids []*string
+	for _, i := range instances {
+		if _, ok := r.drainStarted(i); !ok {
+			ids = append(ids, i.InstanceId)
+		}
+	}
+	if len(ids) > 0 {
+		params := &ec2.CreateTagsInput{
+			Resources: ids,
+			Tags: []*ec2.Tag{
+				{Key: aws.String(drainStartedTagKey), Value: aws.String(time.Now().UTC().Format(time.RFC3339))},
+			},
+		}
+		_, err := r.ec2Cli.CreateTags(params)
+		r.audit(auditActionCreateTags, ids, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < len(instances); i = i + updateContainerInstancesStateMax {
+		end := i + updateContainerInstancesStateMax
+		if end > len(instances) {
+			end = len(instances)
+		}
+		ids := make([]*string, 0, end-i)
+		arns := make([]*string, 0, end-i)
+		for _, in := range instances[i:end] {
+			ids = append(ids, in.InstanceId)
+			arns = append(arns, byID[aws.StringValue(in.InstanceId)].ContainerInstanceArn)
+		}
+
+		params := &ecs.UpdateContainerInstancesStateInput{
+			Cluster:            aws.String(r.clusterName),
+			ContainerInstances: arns,
+			Status:             aws.String(ecs.ContainerInstanceStatusDraining),
+		}
+		resp, err := r.ecsCli.UpdateContainerInstancesState(params)
+		r.audit(auditActionUpdateContainerInstancesState, ids, err)
+		if err != nil {
+			return err
+		}
+		for _, f := range resp.Failures {
+			log.WithFields(logrus.Fields{
+				"arn":    aws.StringValue(f.Arn),
+				"reason": aws.StringValue(f.Reason),
+			}).Error("Error draining target")
+		}
+
+		for _, id := range ids {
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: aws.StringValue(id),
+				logFieldAction:     auditActionUpdateContainerInstancesState,
+			}).Info("Draining target")
+		}
+	}
+	return nil
+}
+
+// audit will record the draining of the instances
+func (r *Replacer) audit(action string, ids []*string, actionErr error) {
+	if r.auditor == nil {
+		return
+	}
+
+	records := make([]*AuditRecord, len(ids))
+	for i, id := range ids {
+		rec := &AuditRecord{
+			Time:       time.Now().UTC(),
+			Cluster:    r.clusterName,
+			Component:  componentGC,
+			Action:     action,
+			InstanceID: aws.StringValue(id),
+			Tags:       map[string]string{r.markTag.key: r.markTag.value},
+			Evidence: AuditEvidence{
+				Thresholds: map[string]string{"drain.timeout": r.drainTimeout.String()},
+			},
+		}
+		if actionErr != nil {
+			rec.Error = actionErr.Error()
+		}
+		records[i] = rec
+	}
+
+	if err := r.auditor.Audit(records...); err != nil {
+		componentLog(r.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func replaceMarked(markTag MarkTag, id string, drainStarted time.Time) *ec2.Instance {
+	i := &ec2.Instance{
+		InstanceId: aws.String(id),
+		Tags:       []*ec2.Tag{{Key: aws.String(markTag.key), Value: aws.String(markTag.value)}},
+	}
+	if !drainStarted.IsZero() {
+		i.Tags = append(i.Tags, &ec2.Tag{Key: aws.String(drainStartedTagKey), Value: aws.String(drainStarted.Format(time.RFC3339))})
+	}
+	return i
+}
+
+func replaceContainerInstance(id string, status string, live int64) *ecs.ContainerInstance {
+	return &ecs.ContainerInstance{
+		Ec2InstanceId:        aws.String(id),
+		ContainerInstanceArn: aws.String("arn-" + id),
+		Status:               aws.String(status),
+		AgentConnected:       aws.Bool(true),
+		RunningTasksCount:    aws.Int64(live),
+		PendingTasksCount:    aws.Int64(0),
+	}
+}
+
+func TestReplacerClean(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+	now := time.Now().UTC()
+	markTag := MarkTag{"key", "value"}
+
+	// i-0 drained, i-1 active with live tasks, i-2 draining past the timeout, i-3 not registered,
+	// i-4 draining with a close scheduled event, i-5 draining with a far scheduled event, i-6
+	// draining with the agent disconnected
+	awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli,
+		replaceMarked(markTag, "i-0", now.Add(-10*time.Minute)),
+		replaceMarked(markTag, "i-1", time.Time{}),
+		replaceMarked(markTag, "i-2", now.Add(-2*time.Hour)),
+		replaceMarked(markTag, "i-3", time.Time{}),
+		replaceMarked(markTag, "i-4", now.Add(-10*time.Minute)),
+		replaceMarked(markTag, "i-5", now.Add(-10*time.Minute)),
+		replaceMarked(markTag, "i-6", now.Add(-10*time.Minute)),
+	)
+	disconnected := replaceContainerInstance("i-6", ecs.ContainerInstanceStatusDraining, 1)
+	disconnected.AgentConnected = aws.Bool(false)
+	awsMock.MockDescribeContainerInstances(t, mockECSCli,
+		replaceContainerInstance("i-0", ecs.ContainerInstanceStatusDraining, 0),
+		replaceContainerInstance("i-1", ecs.ContainerInstanceStatusActive, 2),
+		replaceContainerInstance("i-2", ecs.ContainerInstanceStatusDraining, 1),
+		replaceContainerInstance("i-4", ecs.ContainerInstanceStatusDraining, 1),
+		replaceContainerInstance("i-5", ecs.ContainerInstanceStatusDraining, 1),
+		disconnected,
+	)
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 6)
+	awsMock.MockDescribeInstanceStatusPagesEvents(t, mockEC2Cli, map[string][]*ec2.InstanceStatusEvent{
+		"i-4": {{Code: aws.String(ec2.EventCodeInstanceRetirement), NotBefore: aws.Time(now.Add(5 * time.Minute))}},
+		"i-5": {{Code: aws.String(ec2.EventCodeInstanceRetirement), NotBefore: aws.Time(now.Add(24 * time.Hour))}},
+	})
+
+	updated := map[string]string{}
+	awsMock.MockUpdateContainerInstancesState(t, mockECSCli, updated)
+	tagged := map[string]map[string]string{}
+	awsMock.MockCreateTagsAll(t, mockEC2Cli, tagged)
+	terminatedCalls := []map[string]*ec2.InstanceState{}
+	awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+
+	auditor := &testAuditor{}
+	r := &Replacer{
+		ec2Cli:       mockEC2Cli,
+		ecsCli:       mockECSCli,
+		clusterName:  "test",
+		markTag:      markTag,
+		drainTimeout: time.Hour,
+		auditor:      auditor,
+		killer: &Killer{
+			ec2Cli:      mockEC2Cli,
+			clusterName: "test",
+			markTag:     markTag,
+			step:        100,
+			auditor:     auditor,
+		},
+	}
+
+	if err := r.Clean(); err != nil {
+		t.Fatalf("Clean shouldn't give an error: %s", err)
+	}
+
+	if len(updated) != 1 || updated["arn-i-1"] != ecs.ContainerInstanceStatusDraining {
+		t.Errorf("Only the active instance should start draining; got: %v", updated)
+	}
+	if _, ok := tagged["i-1"][drainStartedTagKey]; !ok || len(tagged) != 1 {
+		t.Errorf("Only the new draining instance should be tagged; got: %v", tagged)
+	}
+
+	var terminated []string
+	for _, c := range terminatedCalls {
+		for id := range c {
+			terminated = append(terminated, id)
+		}
+	}
+	sort.Strings(terminated)
+	want := []string{"i-0", "i-2", "i-3", "i-4", "i-6"}
+	if len(terminated) != len(want) {
+		t.Fatalf("Wrong terminated instances; got: %v, want: %v", terminated, want)
+	}
+	for i := range want {
+		if terminated[i] != want[i] {
+			t.Errorf("Wrong terminated instances; got: %v, want: %v", terminated, want)
+		}
+	}
+}
+
+func TestReplacerCleanDrainBatches(t *testing.T) {
+	tests := []struct {
+		draining int
+		active   int
+		step     int
+
+		wantDrains int
+	}{
+		{0, 4, 50, 2},
+		{1, 3, 50, 1},
+		{2, 2, 50, 0},
+		{0, 4, 100, 4},
+		{0, 3, 10, 1},
+	}
+
+	for _, test := range tests {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		markTag := MarkTag{"key", "value"}
+		var instances []*ec2.Instance
+		var cis []*ecs.ContainerInstance
+		for i := 0; i < test.draining+test.active; i++ {
+			id := fmt.Sprintf("i-%d", i)
+			status := ecs.ContainerInstanceStatusActive
+			started := time.Time{}
+			if i < test.draining {
+				status = ecs.ContainerInstanceStatusDraining
+				started = time.Now().UTC()
+			}
+			instances = append(instances, replaceMarked(markTag, id, started))
+			cis = append(cis, replaceContainerInstance(id, status, 1))
+		}
+		awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli, instances...)
+		awsMock.MockDescribeContainerInstances(t, mockECSCli, cis...)
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, len(cis))
+		awsMock.MockDescribeInstanceStatusPagesEvents(t, mockEC2Cli, nil)
+		updated := map[string]string{}
+		awsMock.MockUpdateContainerInstancesState(t, mockECSCli, updated)
+		awsMock.MockCreateTagsAll(t, mockEC2Cli, map[string]map[string]string{})
+
+		r := &Replacer{
+			ec2Cli:      mockEC2Cli,
+			ecsCli:      mockECSCli,
+			clusterName: "test",
+			markTag:     markTag,
+			killer:      &Killer{step: test.step},
+		}
+
+		if err := r.Clean(); err != nil {
+			t.Errorf("%+v\n- Clean shouldn't give an error: %s", test, err)
+		}
+		if len(updated) != test.wantDrains {
+			t.Errorf("%+v\n- Wrong number of instances starting the drain; got: %d, want: %d", test, len(updated), test.wantDrains)
+		}
+	}
+}
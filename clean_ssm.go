@@ -0,0 +1,239 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+const (
+	// The parameters of the shell script documents with the commands to run and the
+	// time they have to finish
+	ssmCommandsParameter         = "commands"
+	ssmExecutionTimeoutParameter = "executionTimeout"
+
+	// The maximum instances of a command
+	ssmMaxInstanceIDs = 50
+)
+
+// AgentRestarter will run an SSM document on the marked instances, by default restarting
+// the ECS agent and docker, most of the disconnected agents are fixed with a restart
+type AgentRestarter struct {
+	ssmCli  ssmiface.SSMAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The SSM document to run and its commands parameter
+	document string
+	commands []string
+
+	// The time the command has to finish on the instances
+	timeout time.Duration
+
+	// The auditor of the restarting actions
+	auditor Auditor
+}
+
+// NewAgentRestarter creates a new agent restarter
+func NewAgentRestarter(clusterName string, awsRegion string, document string, commands []string, timeout time.Duration, auditor Auditor) (*AgentRestarter, error) {
+	r := &AgentRestarter{
+		clusterName: clusterName,
+		document:    document,
+		commands:    commands,
+		timeout:     timeout,
+		auditor:     auditor,
+	}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	r.session = s
+
+	// Create the AWS SSM client
+	r.ssmCli = ssm.New(s)
+
+	return r, nil
+}
+
+// Remediate will send the command to the instances without waiting it, its outcome is checked
+// on the next iterations by Failed
+func (r *AgentRestarter) Remediate(instances []*ec2.Instance) ([]*ec2.Instance, error) {
+	ids := make([]*string, len(instances))
+	for it, i := range instances {
+		ids[it] = i.InstanceId
+	}
+
+	// A command can't be sent to more than 50 instances, send one for each batch
+	for start := 0; start < len(ids); start += ssmMaxInstanceIDs {
+		end := start + ssmMaxInstanceIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if _, err := r.send(ids[start:end]); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// Failed returns the instances where the command finished without success, the command of an
+// instance is the last invocation of the document since its escalation step started
+func (r *AgentRestarter) Failed(instances []*ec2.Instance, registered map[string]*ecs.ContainerInstance) ([]*ec2.Instance, error) {
+	log := componentLog(r.clusterName, componentGC)
+
+	started := map[string]time.Time{}
+	var since time.Time
+	for _, i := range instances {
+		v, ok := instanceTag(i, escalationStartedTagKey)
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			continue
+		}
+		started[aws.StringValue(i.InstanceId)] = t
+		if since.IsZero() || t.Before(since) {
+			since = t
+		}
+	}
+	if len(started) == 0 {
+		return nil, nil
+	}
+
+	last := map[string]*ssm.CommandInvocation{}
+	params := &ssm.ListCommandInvocationsInput{
+		Filters: []*ssm.CommandFilter{
+			{
+				Key:   aws.String(ssm.CommandFilterKeyInvokedAfter),
+				Value: aws.String(since.Format(time.RFC3339)),
+			},
+		},
+	}
+	err := r.ssmCli.ListCommandInvocationsPages(params,
+		func(page *ssm.ListCommandInvocationsOutput, lastPage bool) bool {
+			for _, ci := range page.CommandInvocations {
+				id := aws.StringValue(ci.InstanceId)
+				st, ok := started[id]
+				if !ok || aws.StringValue(ci.DocumentName) != r.document || ci.RequestedDateTime == nil || ci.RequestedDateTime.Before(st) {
+					continue
+				}
+				if l, ok := last[id]; ok && l.RequestedDateTime.After(*ci.RequestedDateTime) {
+					continue
+				}
+				last[id] = ci
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []*ec2.Instance
+	for _, i := range instances {
+		id := aws.StringValue(i.InstanceId)
+		ci, ok := last[id]
+		if !ok {
+			continue
+		}
+		status := aws.StringValue(ci.Status)
+		if !ssmInvocationFinished(status) || status == ssm.CommandInvocationStatusSuccess {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			logFieldInstanceID: id,
+			logFieldAction:     auditActionSendCommand,
+			"command_id":       aws.StringValue(ci.CommandId),
+			"status":           status,
+		}).Warning("Remediation command failed")
+		failed = append(failed, i)
+	}
+	return failed, nil
+}
+
+// send will send the command to the instances, the command has the restarter timeout to
+// finish on each instance, it returns the command ID
+func (r *AgentRestarter) send(ids []*string) (string, error) {
+	timeout := int64(r.timeout.Seconds())
+	params := &ssm.SendCommandInput{
+		DocumentName: aws.String(r.document),
+		InstanceIds:  ids,
+		Comment:      aws.String("ecs-watcher remediation"),
+		// This is the time to deliver the command to the instances, not to run it
+		TimeoutSeconds: aws.Int64(timeout),
+	}
+	if len(r.commands) > 0 {
+		params.Parameters = map[string][]*string{
+			ssmCommandsParameter:         aws.StringSlice(r.commands),
+			ssmExecutionTimeoutParameter: {aws.String(strconv.FormatInt(timeout, 10))},
+		}
+	}
+	resp, err := r.ssmCli.SendCommand(params)
+	r.audit(auditActionSendCommand, ids, err)
+	if err != nil {
+		return "", err
+	}
+	commandID := aws.StringValue(resp.Command.CommandId)
+	componentLog(r.clusterName, componentGC).WithFields(logrus.Fields{
+		"command_id": commandID,
+		"document":   r.document,
+		"instances":  len(ids),
+	}).Info("Sent remediation command")
+
+	return commandID, nil
+}
+
+// ssmInvocationFinished returns true if the invocation status is final
+func ssmInvocationFinished(status string) bool {
+	switch status {
+	case ssm.CommandInvocationStatusPending, ssm.CommandInvocationStatusInProgress, ssm.CommandInvocationStatusCancelling:
+		return false
+	}
+	return true
+}
+
+// audit will record the restarting actions
+func (r *AgentRestarter) audit(action string, ids []*string, actionErr error) {
+	if r.auditor == nil {
+		return
+	}
+
+	records := make([]*AuditRecord, len(ids))
+	for i, id := range ids {
+		rec := &AuditRecord{
+			Time:       time.Now().UTC(),
+			Cluster:    r.clusterName,
+			Component:  componentGC,
+			Action:     action,
+			InstanceID: aws.StringValue(id),
+			Evidence: AuditEvidence{
+				Thresholds: map[string]string{
+					"ssm.document": r.document,
+					"ssm.commands": strings.Join(r.commands, ";"),
+					"ssm.timeout":  r.timeout.String(),
+				},
+			},
+		}
+		if actionErr != nil {
+			rec.Error = actionErr.Error()
+		}
+		records[i] = rec
+	}
+
+	if err := r.auditor.Audit(records...); err != nil {
+		componentLog(r.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
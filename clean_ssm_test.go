@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestAgentRestarterSendCommandError(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSSMCli := sdk.NewMockSSMAPI(ctrl)
+
+	// Set our mock desired result
+	awsMock.MockSendCommandError(t, mockSSMCli)
+
+	r := &AgentRestarter{
+		document: defaultSSMDocument,
+		timeout:  time.Minute,
+	}
+	r.ssmCli = mockSSMCli
+
+	if _, err := r.Remediate([]*ec2.Instance{{InstanceId: aws.String("i-0")}}); err == nil {
+		t.Errorf("Remediate should give an error, it didn't")
+	}
+}
+
+func TestAgentRestarterRemediateBatches(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSSMCli := sdk.NewMockSSMAPI(ctrl)
+
+	// Set our mock desired result
+	var instances []*ec2.Instance
+	for i := 0; i < 120; i++ {
+		instances = append(instances, &ec2.Instance{InstanceId: aws.String(fmt.Sprintf("i-%d", i))})
+	}
+	inputs := []*ssm.SendCommandInput{}
+	awsMock.MockSendCommandInputs(t, mockSSMCli, "cmd-", &inputs)
+
+	r := &AgentRestarter{
+		clusterName: "test",
+		document:    defaultSSMDocument,
+		commands:    []string{"restart"},
+		timeout:     time.Minute,
+	}
+	r.ssmCli = mockSSMCli
+
+	skipped, err := r.Remediate(instances)
+	if err != nil {
+		t.Errorf("Remediate shouldn't give an error: %s", err)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("Wrong number of skipped instances; got: %d, want: 0", len(skipped))
+	}
+
+	wantBatches := []int{50, 50, 20}
+	if len(inputs) != len(wantBatches) {
+		t.Fatalf("Wrong number of sent commands; got: %d, want: %d", len(inputs), len(wantBatches))
+	}
+	for i, in := range inputs {
+		if len(in.InstanceIds) != wantBatches[i] {
+			t.Errorf("Wrong number of instances on command %d; got: %d, want: %d", i, len(in.InstanceIds), wantBatches[i])
+		}
+		et := in.Parameters[ssmExecutionTimeoutParameter]
+		if len(et) != 1 || aws.StringValue(et[0]) != "60" {
+			t.Errorf("Wrong execution timeout on command %d; got: %v, want: 60", i, aws.StringValueSlice(et))
+		}
+	}
+}
+
+func TestAgentRestarterFailed(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSSMCli := sdk.NewMockSSMAPI(ctrl)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	started := now.Add(-2 * time.Minute)
+	invocation := func(id string, document string, requested time.Time, status string) *ssm.CommandInvocation {
+		return &ssm.CommandInvocation{
+			CommandId:         aws.String("cmd-" + id),
+			InstanceId:        aws.String(id),
+			DocumentName:      aws.String(document),
+			RequestedDateTime: aws.Time(requested),
+			Status:            aws.String(status),
+		}
+	}
+
+	// i-0 succeeded, i-1 failed, i-2 in progress, i-3 failed before the step started, i-4 failed
+	// another document, i-5 failed and succeeded after a retry, i-6 doesn't have invocations
+	awsMock.MockListCommandInvocationsPages(t, mockSSMCli,
+		invocation("i-0", defaultSSMDocument, started.Add(time.Second), ssm.CommandInvocationStatusSuccess),
+		invocation("i-1", defaultSSMDocument, started.Add(time.Second), ssm.CommandInvocationStatusFailed),
+		invocation("i-2", defaultSSMDocument, started.Add(time.Second), ssm.CommandInvocationStatusInProgress),
+		invocation("i-3", defaultSSMDocument, started.Add(-time.Hour), ssm.CommandInvocationStatusFailed),
+		invocation("i-4", "other", started.Add(time.Second), ssm.CommandInvocationStatusTimedOut),
+		invocation("i-5", defaultSSMDocument, started.Add(2*time.Second), ssm.CommandInvocationStatusSuccess),
+		invocation("i-5", defaultSSMDocument, started.Add(time.Second), ssm.CommandInvocationStatusFailed),
+	)
+
+	var instances []*ec2.Instance
+	for i := 0; i < 7; i++ {
+		instances = append(instances, escalatedInstance(fmt.Sprintf("i-%d", i), restartRemediatorName, started))
+	}
+
+	r := &AgentRestarter{
+		clusterName: "test",
+		document:    defaultSSMDocument,
+		timeout:     time.Minute,
+	}
+	r.ssmCli = mockSSMCli
+
+	failed, err := r.Failed(instances, nil)
+	if err != nil {
+		t.Fatalf("Failed shouldn't give an error: %s", err)
+	}
+	if len(failed) != 1 || aws.StringValue(failed[0].InstanceId) != "i-1" {
+		var ids []string
+		for _, i := range failed {
+			ids = append(ids, aws.StringValue(i.InstanceId))
+		}
+		t.Errorf("Wrong failed instances; got: %v, want: [i-1]", ids)
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// StaleCleaner will deregister the container instances of the cluster whose EC2 instance
+// doesn't exist or is terminated, like the ones terminated outside of the watcher. It runs
+// once per interval and deregisters a batch on each run
+type StaleCleaner struct {
+	ec2Cli  ec2iface.EC2API
+	ecsCli  ecsiface.ECSAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// the step percent of the cluster container instances deregistered on each run
+	step int
+
+	// The time between the runs, the collections in between are skipped
+	interval time.Duration
+	lastRun  time.Time
+
+	// The batch of the current deregistrations, each run is a batch
+	batch int
+
+	// Only log the stale container instances, don't deregister them
+	dryRun bool
+
+	// The auditor of the deregistering actions
+	auditor Auditor
+}
+
+// NewStaleCleaner creates a new stale registrations cleaner
+func NewStaleCleaner(clusterName string, awsRegion string, stepPercent int, interval time.Duration, dryRun bool, auditor Auditor) (*StaleCleaner, error) {
+	c := &StaleCleaner{
+		clusterName: clusterName,
+		step:        stepPercent,
+		interval:    interval,
+		dryRun:      dryRun,
+		auditor:     auditor,
+	}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	c.session = s
+
+	// Create the AWS clients
+	c.ec2Cli = ec2.New(s)
+	c.ecsCli = ecs.New(s)
+
+	return c, nil
+}
+
+// Clean will deregister a batch of the stale container instances, the next batch is
+// deregistered on the next run so the cluster has time to settle
+func (c *StaleCleaner) Clean() error {
+	log := componentLog(c.clusterName, componentGC)
+
+	now := time.Now().UTC()
+	if !c.lastRun.IsZero() && now.Sub(c.lastRun) < c.interval {
+		return nil
+	}
+	c.lastRun = now
+
+	stale, total, err := c.staleContainerInstances()
+	if err != nil {
+		return err
+	}
+	if len(stale) == 0 {
+		log.Debug("No stale container instances")
+		c.batch = 0
+		return nil
+	}
+
+	// Get the number of container instances per step
+	n := c.step * total / 100
+	if n == 0 {
+		n = 1
+	}
+	if n > len(stale) {
+		n = len(stale)
+	}
+	c.batch++
+	log.WithFields(logrus.Fields{
+		"total":       len(stale),
+		"batch_size":  n,
+		logFieldBatch: c.batch,
+		"dry_run":     c.dryRun,
+	}).Info("Deregistering a batch of stale container instances")
+
+	for _, ci := range stale[:n] {
+		fields := logrus.Fields{
+			logFieldInstanceID:   aws.StringValue(ci.Ec2InstanceId),
+			logFieldBatch:        c.batch,
+			logFieldAction:       auditActionDeregisterContainerInstance,
+			"container_instance": aws.StringValue(ci.ContainerInstanceArn),
+		}
+		if c.dryRun {
+			log.WithFields(fields).Info("Dry run, stale container instance not deregistered")
+			continue
+		}
+
+		params := &ecs.DeregisterContainerInstanceInput{
+			Cluster:           aws.String(c.clusterName),
+			ContainerInstance: ci.ContainerInstanceArn,
+			Force:             aws.Bool(true),
+		}
+		_, err := c.ecsCli.DeregisterContainerInstance(params)
+		c.audit(auditActionDeregisterContainerInstance, ci, c.batch, err)
+		if err != nil {
+			return err
+		}
+		log.WithFields(fields).Info("Deregistered stale container instance")
+	}
+
+	if waiting := len(stale) - n; waiting > 0 {
+		log.WithFields(logrus.Fields{
+			"waiting":  waiting,
+			"interval": c.interval.String(),
+		}).Info("Stale container instances left for the next run")
+	}
+	return nil
+}
+
+// staleContainerInstances returns the container instances whose EC2 instance is gone and the
+// total of container instances of the cluster
+func (c *StaleCleaner) staleContainerInstances() ([]*ecs.ContainerInstance, int, error) {
+	cis, err := describeContainerInstances(c.ecsCli, c.clusterName)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(cis) == 0 {
+		return nil, 0, nil
+	}
+
+	ids := make([]*string, len(cis))
+	for i, ci := range cis {
+		ids[i] = ci.Ec2InstanceId
+	}
+	states, err := describeInstanceStates(c.ec2Cli, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var stale []*ecs.ContainerInstance
+	for _, ci := range cis {
+		state, ok := states[aws.StringValue(ci.Ec2InstanceId)]
+		if !ok || state == ec2.InstanceStateNameTerminated {
+			stale = append(stale, ci)
+		}
+	}
+	return stale, len(cis), nil
+}
+
+// audit will record the deregistering of a stale container instance
+func (c *StaleCleaner) audit(action string, ci *ecs.ContainerInstance, batch int, actionErr error) {
+	if c.auditor == nil {
+		return
+	}
+
+	r := &AuditRecord{
+		Time:       time.Now().UTC(),
+		Cluster:    c.clusterName,
+		Component:  componentGC,
+		Action:     action,
+		InstanceID: aws.StringValue(ci.Ec2InstanceId),
+		Evidence: AuditEvidence{
+			Batch: batch,
+			Thresholds: map[string]string{
+				"gc.step.percent": strconv.Itoa(c.step),
+				"stale.interval":  c.interval.String(),
+			},
+		},
+	}
+	if actionErr != nil {
+		r.Error = actionErr.Error()
+	}
+
+	if err := c.auditor.Audit(r); err != nil {
+		componentLog(c.clusterName, componentGC).WithError(err).WithField(logFieldAction, action).Error("Error auditing")
+	}
+}
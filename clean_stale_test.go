@@ -0,0 +1,89 @@
+package main
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestStaleCleanerClean(t *testing.T) {
+	tests := []struct {
+		dryRun bool
+		step   int
+
+		wantDeregistered []string
+	}{
+		{false, 100, []string{"arn-1", "arn-2"}},
+		// A batch of a third of the cluster on each run
+		{false, 34, []string{"arn-1"}},
+		{true, 100, nil},
+	}
+
+	for _, test := range tests {
+		ctrl := gomock.NewController(t)
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+
+		// i-0 running, i-1 terminated, i-2 missing
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 3)
+		awsMock.MockDescribeContainerInstances(t, mockECSCli,
+			&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-0"), ContainerInstanceArn: aws.String("arn-0")},
+			&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-1"), ContainerInstanceArn: aws.String("arn-1")},
+			&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-2"), ContainerInstanceArn: aws.String("arn-2")},
+		)
+		awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli,
+			&ec2.Instance{InstanceId: aws.String("i-0"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)}},
+			&ec2.Instance{InstanceId: aws.String("i-1"), State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameTerminated)}},
+		)
+		var deregistered []string
+		awsMock.MockDeregisterContainerInstance(t, mockECSCli, &deregistered)
+
+		auditor := &testAuditor{}
+		c := &StaleCleaner{
+			ec2Cli:      mockEC2Cli,
+			ecsCli:      mockECSCli,
+			clusterName: "test",
+			step:        test.step,
+			interval:    time.Hour,
+			dryRun:      test.dryRun,
+			auditor:     auditor,
+		}
+
+		if err := c.Clean(); err != nil {
+			t.Errorf("%+v\n- Clean shouldn't give an error: %s", test, err)
+		}
+		// The next collections before the interval don't run
+		if err := c.Clean(); err != nil {
+			t.Errorf("%+v\n- Clean shouldn't give an error: %s", test, err)
+		}
+
+		sort.Strings(deregistered)
+		if len(deregistered) != len(test.wantDeregistered) {
+			t.Fatalf("%+v\n- Wrong deregistered container instances; got: %v, want: %v", test, deregistered, test.wantDeregistered)
+		}
+		for i := range deregistered {
+			if deregistered[i] != test.wantDeregistered[i] {
+				t.Errorf("%+v\n- Wrong deregistered container instances; got: %v, want: %v", test, deregistered, test.wantDeregistered)
+			}
+		}
+
+		// One per deregistration, all of them on the first batch
+		if len(auditor.records) != len(test.wantDeregistered) {
+			t.Errorf("%+v\n- Wrong number of audit records; got: %d, want: %d", test, len(auditor.records), len(test.wantDeregistered))
+		}
+		for _, r := range auditor.records {
+			if r.Evidence.Batch != 1 {
+				t.Errorf("%+v\n- Wrong audit record batch; got: %d, want: %d", test, r.Evidence.Batch, 1)
+			}
+		}
+		ctrl.Finish()
+	}
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// The tag of the surged instances with their autoscaling group and the time the desired
+// capacity was raised, so the next collections don't raise it again
+const surgeTagKey = "ecs-watcher:surge"
+
+// surgeState is the surge of an instance stored on its tag as "group,RFC3339"
+type surgeState struct {
+	group   string
+	started time.Time
+}
+
+func (s surgeState) String() string {
+	return fmt.Sprintf("%s,%s", s.group, s.started.UTC().Format(time.RFC3339))
+}
+
+// parseSurgeState parses the surge tag of an instance
+func parseSurgeState(v string) (surgeState, error) {
+	parts := strings.SplitN(v, ",", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return surgeState{}, fmt.Errorf("wrong %s tag: %s", surgeTagKey, v)
+	}
+	t, err := time.Parse(time.RFC3339, parts[1])
+	if err != nil {
+		return surgeState{}, fmt.Errorf("wrong %s tag: %s", surgeTagKey, v)
+	}
+	return surgeState{group: parts[0], started: t}, nil
+}
+
+// Surge replaces the instances of a batch before terminating them (make before break). The
+// desired capacity of their autoscaling groups is raised by the batch size and the next
+// collections check until each group has the replacements registered and connected to the
+// cluster, then the instances are terminated decrementing the desired capacity. If the
+// replacements aren't ready on time the raised capacity is restored and the batch isn't terminated
+type Surge struct {
+	asgCli autoscalingiface.AutoScalingAPI
+	ec2Cli ec2iface.EC2API
+	ecsCli ecsiface.ECSAPI
+
+	// the name of the cluster
+	clusterName string
+
+	// The maximum time waiting for the replacements, after it the surge is restored
+	timeout time.Duration
+}
+
+// NewSurge creates a Surge
+func NewSurge(clusterName string, awsRegion string, timeout time.Duration) (*Surge, error) {
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Surge{
+		asgCli:      autoscaling.New(s),
+		ec2Cli:      ec2.New(s),
+		ecsCli:      ecs.New(s),
+		clusterName: clusterName,
+		timeout:     timeout,
+	}, nil
+}
+
+// replace raises the desired capacity of the autoscaling groups of the targets and checks their
+// replacements, it returns the autoscaling group of the surged targets and if the replacements
+// are ready. The targets that aren't on an autoscaling group aren't replaced, the ones surged by
+// a previous collection don't raise the capacity again and keep their deadline. If the
+// replacements aren't ready after the timeout the raised capacity is restored and an error is
+// returned, so the batch isn't terminated
+func (s *Surge) replace(targets []*ec2.Instance, batch int) (map[string]string, bool, error) {
+	if s == nil {
+		return nil, true, nil
+	}
+	log := componentLog(s.clusterName, componentGC).WithField(logFieldBatch, batch)
+
+	groups, err := instanceAutoScalingGroups(s.asgCli, targets)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(groups) == 0 {
+		log.Debug("No targets on autoscaling groups to surge")
+		return groups, true, nil
+	}
+
+	// The surge of the targets, the new ones are raised now
+	states := map[string]surgeState{}
+	raise := map[string]int64{}
+	var raised []*ec2.Instance
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, t := range targets {
+		id := aws.StringValue(t.InstanceId)
+		g, ok := groups[id]
+		if !ok {
+			continue
+		}
+		if v, ok := instanceTag(t, surgeTagKey); ok {
+			st, err := parseSurgeState(v)
+			if err == nil && st.group == g {
+				states[id] = st
+				continue
+			}
+			log.WithFields(logrus.Fields{
+				logFieldInstanceID: id,
+				"tag":              v,
+			}).Warning("Ignoring surge tag")
+		}
+		states[id] = surgeState{group: g, started: now}
+		raise[g]++
+		raised = append(raised, t)
+	}
+
+	if len(raise) > 0 {
+		if err := s.raise(raise); err != nil {
+			return nil, false, err
+		}
+		if err := s.tag(raised, states); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Each group waits for its own replacements, launched after its first surge
+	want := map[string]int64{}
+	started := map[string]time.Time{}
+	for _, st := range states {
+		want[st.group]++
+		if t, ok := started[st.group]; !ok || st.started.Before(t) {
+			started[st.group] = st.started
+		}
+	}
+	deadline := now.Add(s.timeout)
+	for _, t := range started {
+		if d := t.Add(s.timeout); d.Before(deadline) {
+			deadline = d
+		}
+	}
+
+	ready, desired, err := s.replacements(started, targets)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var waiting []string
+	for g, n := range want {
+		if ready[g] < n {
+			waiting = append(waiting, g)
+		}
+	}
+	if len(waiting) == 0 {
+		log.Info("Surge replacements ready")
+		return groups, true, nil
+	}
+	l := log.WithFields(logrus.Fields{
+		"asgs":    strings.Join(waiting, ","),
+		"timeout": s.timeout,
+	})
+	if time.Now().After(deadline) {
+		l.Warning("Surge replacements not ready, restoring the desired capacity")
+		if err := s.restore(want, desired, targets); err != nil {
+			return nil, false, err
+		}
+		return nil, false, fmt.Errorf("surge replacements of %s not ready after %s", strings.Join(waiting, ","), s.timeout)
+	}
+	l.Info("Waiting surge replacements")
+	return groups, false, nil
+}
+
+// replacements returns the replacements of each autoscaling group and their desired capacity,
+// the replacements are the group instances launched after the surge started that are registered,
+// ACTIVE and with the agent connected
+func (s *Surge) replacements(started map[string]time.Time, targets []*ec2.Instance) (map[string]int64, map[string]int64, error) {
+	skip := map[string]bool{}
+	for _, t := range targets {
+		skip[aws.StringValue(t.InstanceId)] = true
+	}
+
+	names := make([]*string, 0, len(started))
+	for g := range started {
+		names = append(names, aws.String(g))
+	}
+	members := map[string]string{}
+	desired := map[string]int64{}
+	params := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: names,
+	}
+	err := s.asgCli.DescribeAutoScalingGroupsPages(params,
+		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			for _, g := range page.AutoScalingGroups {
+				name := aws.StringValue(g.AutoScalingGroupName)
+				desired[name] = aws.Int64Value(g.DesiredCapacity)
+				for _, i := range g.Instances {
+					if id := aws.StringValue(i.InstanceId); !skip[id] {
+						members[id] = name
+					}
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ready := map[string]int64{}
+	if len(members) == 0 {
+		return ready, desired, nil
+	}
+
+	cis, err := describeContainerInstances(s.ecsCli, s.clusterName)
+	if err != nil {
+		return nil, nil, err
+	}
+	connected := map[string]bool{}
+	for _, ci := range cis {
+		if aws.BoolValue(ci.AgentConnected) && aws.StringValue(ci.Status) == containerInstanceStatusActive {
+			connected[aws.StringValue(ci.Ec2InstanceId)] = true
+		}
+	}
+
+	var ids []*string
+	for id := range members {
+		if connected[id] {
+			ids = append(ids, aws.String(id))
+		}
+	}
+	if len(ids) == 0 {
+		return ready, desired, nil
+	}
+	err = s.ec2Cli.DescribeInstancesPages(&ec2.DescribeInstancesInput{InstanceIds: ids},
+		func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, r := range page.Reservations {
+				for _, i := range r.Instances {
+					g := members[aws.StringValue(i.InstanceId)]
+					if i.LaunchTime != nil && !i.LaunchTime.Before(started[g]) {
+						ready[g]++
+					}
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+	return ready, desired, nil
+}
+
+// raise raises the desired capacity of the autoscaling groups, it fails before raising any of
+// them if the capacity of one would exceed its max size
+func (s *Surge) raise(surge map[string]int64) error {
+	log := componentLog(s.clusterName, componentGC)
+
+	names := make([]*string, 0, len(surge))
+	for g := range surge {
+		names = append(names, aws.String(g))
+	}
+	desired := map[string]int64{}
+	var maxErr error
+	params := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: names,
+	}
+	err := s.asgCli.DescribeAutoScalingGroupsPages(params,
+		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			for _, g := range page.AutoScalingGroups {
+				name := aws.StringValue(g.AutoScalingGroupName)
+				d := aws.Int64Value(g.DesiredCapacity) + surge[name]
+				if max := aws.Int64Value(g.MaxSize); d > max {
+					maxErr = fmt.Errorf("surge of %d instances exceeds the max size %d of %s", surge[name], max, name)
+				}
+				desired[name] = d
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+	if maxErr != nil {
+		return maxErr
+	}
+
+	for g, d := range desired {
+		if err := s.setDesired(g, d); err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"asg":     g,
+			"desired": d,
+		}).Info("Raised autoscaling group desired capacity")
+	}
+	return nil
+}
+
+// restore lowers the desired capacity of the autoscaling groups by their surge and removes the
+// surge tag of the targets, so the next try starts a new surge
+func (s *Surge) restore(surge, desired map[string]int64, targets []*ec2.Instance) error {
+	log := componentLog(s.clusterName, componentGC)
+
+	for g, n := range surge {
+		d := desired[g] - n
+		if d < 0 {
+			d = 0
+		}
+		if err := s.setDesired(g, d); err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"asg":     g,
+			"desired": d,
+		}).Info("Restored autoscaling group desired capacity")
+	}
+
+	ids := make([]*string, len(targets))
+	for i, t := range targets {
+		ids[i] = t.InstanceId
+	}
+	params := &ec2.DeleteTagsInput{
+		Resources: ids,
+		Tags:      []*ec2.Tag{{Key: aws.String(surgeTagKey)}},
+	}
+	_, err := s.ec2Cli.DeleteTags(params)
+	return err
+}
+
+// tag stores the surge of the instances on their surge tag
+func (s *Surge) tag(instances []*ec2.Instance, states map[string]surgeState) error {
+	for _, i := range instances {
+		params := &ec2.CreateTagsInput{
+			Resources: []*string{i.InstanceId},
+			Tags: []*ec2.Tag{
+				{Key: aws.String(surgeTagKey), Value: aws.String(states[aws.StringValue(i.InstanceId)].String())},
+			},
+		}
+		if _, err := s.ec2Cli.CreateTags(params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDesired sets the desired capacity of an autoscaling group
+func (s *Surge) setDesired(group string, desired int64) error {
+	params := &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: aws.String(group),
+		DesiredCapacity:      aws.Int64(desired),
+		HonorCooldown:        aws.Bool(false),
+	}
+	_, err := s.asgCli.SetDesiredCapacity(params)
+	return err
+}
+
+// terminate terminates a surged instance decrementing the desired capacity of its autoscaling group
+func (s *Surge) terminate(i *ec2.Instance) error {
+	params := &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     i.InstanceId,
+		ShouldDecrementDesiredCapacity: aws.Bool(true),
+	}
+	_, err := s.asgCli.TerminateInstanceInAutoScalingGroup(params)
+	return err
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func surgeTestContainerInstances(ids ...string) []*ecs.ContainerInstance {
+	cis := make([]*ecs.ContainerInstance, len(ids))
+	for i, id := range ids {
+		cis[i] = &ecs.ContainerInstance{
+			Ec2InstanceId:  aws.String(id),
+			AgentConnected: aws.Bool(true),
+			Status:         aws.String(containerInstanceStatusActive),
+		}
+	}
+	return cis
+}
+
+func TestKillerSurge(t *testing.T) {
+	surged := surgeState{group: "asg-0", started: time.Now().UTC().Add(-time.Minute)}.String()
+
+	tests := []struct {
+		name     string
+		desired  int64
+		max      int64
+		surgeTag string
+		sequence [][]*ecs.ContainerInstance
+		timeout  time.Duration
+		runs     int
+
+		wantDesired   int64
+		wantASGKilled []string
+		wantEC2Killed int
+		wantError     bool
+	}{
+		{
+			name:    "The replacements join one by one on each collection",
+			desired: 4, max: 6,
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-0", "i-1", "i-2", "i-4"),
+				surgeTestContainerInstances("i-0", "i-1", "i-2", "i-4", "i-5"),
+				surgeTestContainerInstances("i-0", "i-1", "i-2", "i-4", "i-5", "i-6"),
+			},
+			timeout:       time.Minute,
+			runs:          3,
+			wantDesired:   6,
+			wantASGKilled: []string{"i-0", "i-1"},
+			wantEC2Killed: 1,
+		},
+		{
+			name:    "Only the replacements of the surged group count, the batch waits",
+			desired: 4, max: 6,
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-0", "i-1", "i-2", "i-4", "i-5", "i-7"),
+			},
+			timeout:     time.Minute,
+			runs:        2,
+			wantDesired: 6,
+		},
+		{
+			name:    "Surged on a previous collection, the surge is restored after the timeout",
+			desired: 6, max: 6, surgeTag: surged,
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-0", "i-1", "i-2", "i-4", "i-5"),
+			},
+			timeout:     10 * time.Second,
+			runs:        1,
+			wantDesired: 4,
+			wantError:   true,
+		},
+		{
+			name:    "No room to surge, the batch isn't terminated",
+			desired: 5, max: 6,
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-0", "i-1", "i-2"),
+			},
+			timeout:     time.Minute,
+			runs:        1,
+			wantDesired: 5,
+			wantError:   true,
+		},
+		{
+			name:    "Surged on a previous try, the capacity isn't raised again",
+			desired: 6, max: 6, surgeTag: surged,
+			sequence: [][]*ecs.ContainerInstance{
+				surgeTestContainerInstances("i-0", "i-1", "i-2", "i-4", "i-5", "i-6"),
+			},
+			timeout:       time.Hour,
+			runs:          1,
+			wantDesired:   6,
+			wantASGKilled: []string{"i-0", "i-1"},
+			wantEC2Killed: 1,
+		},
+	}
+
+	for _, test := range tests {
+		ctrl := gomock.NewController(t)
+		mockEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockSurgeEC2Cli := sdk.NewMockEC2API(ctrl)
+		mockECSCli := sdk.NewMockECSAPI(ctrl)
+		mockASGCli := sdk.NewMockAutoScalingAPI(ctrl)
+
+		// i-0 and i-1 are marked on asg-0, i-2 is marked and isn't on an autoscaling group.
+		// i-5 and i-6 are the asg-0 replacements, i-7 is a new instance of asg-1
+		var marked []*ec2.Instance
+		for _, id := range []string{"i-0", "i-1", "i-2"} {
+			i := &ec2.Instance{InstanceId: aws.String(id)}
+			if test.surgeTag != "" && id != "i-2" {
+				i.Tags = []*ec2.Tag{{Key: aws.String(surgeTagKey), Value: aws.String(test.surgeTag)}}
+			}
+			marked = append(marked, i)
+		}
+		awsMock.MockDescribeInstancesPagesInstances(t, mockEC2Cli, marked...)
+		awsMock.MockDescribeAutoScalingInstancesPages(t, mockASGCli, map[string]string{"i-0": "asg-0", "i-1": "asg-0"})
+		asg := func(name string, ids ...string) *autoscaling.Group {
+			g := &autoscaling.Group{AutoScalingGroupName: aws.String(name), MaxSize: aws.Int64(test.max)}
+			for _, id := range ids {
+				g.Instances = append(g.Instances, &autoscaling.Instance{InstanceId: aws.String(id)})
+			}
+			return g
+		}
+		desired := map[string]int64{"asg-0": test.desired, "asg-1": 1}
+		awsMock.MockAutoScalingGroupsDesired(t, mockASGCli, desired,
+			asg("asg-0", "i-0", "i-1", "i-4", "i-5", "i-6"),
+			asg("asg-1", "i-7"),
+		)
+		awsMock.MockDescribeInstancesPagesLaunched(t, mockSurgeEC2Cli, "i-5", "i-6", "i-7")
+		awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 1)
+		awsMock.MockDescribeContainerInstancesSequence(t, mockECSCli, test.sequence...)
+		tagged := map[string]map[string]string{}
+		awsMock.MockCreateTagsAll(t, mockSurgeEC2Cli, tagged)
+		untagged := map[string][]string{}
+		awsMock.MockDeleteTags(t, mockSurgeEC2Cli, untagged)
+
+		var asgKilled []string
+		awsMock.MockTerminateInstanceInAutoScalingGroup(t, mockASGCli, &asgKilled)
+		ec2Killed := []map[string]*ec2.InstanceState{}
+		awsMock.MockTerminateInstances(t, mockEC2Cli, &ec2Killed)
+
+		k := &Killer{
+			clusterName: "test",
+			markTag:     MarkTag{"key", "value"},
+			step:        100,
+			ec2Cli:      mockEC2Cli,
+			surge: &Surge{
+				asgCli:      mockASGCli,
+				ec2Cli:      mockSurgeEC2Cli,
+				ecsCli:      mockECSCli,
+				clusterName: "test",
+				timeout:     test.timeout,
+			},
+		}
+
+		// Each collection sees the surge tags of the previous ones
+		var err error
+		for r := 0; r < test.runs; r++ {
+			if err != nil {
+				t.Errorf("%s: Clean %d shouldn't give an error: %s", test.name, r, err)
+			}
+			err = k.Clean()
+			for _, i := range marked {
+				if v, ok := tagged[aws.StringValue(i.InstanceId)][surgeTagKey]; ok {
+					if _, ok := instanceTag(i, surgeTagKey); !ok {
+						i.Tags = append(i.Tags, &ec2.Tag{Key: aws.String(surgeTagKey), Value: aws.String(v)})
+					}
+				}
+			}
+		}
+		ctrl.Finish()
+		if test.wantError != (err != nil) {
+			t.Errorf("%s: Wrong Clean error; got: %v", test.name, err)
+		}
+
+		if desired["asg-0"] != test.wantDesired || desired["asg-1"] != 1 {
+			t.Errorf("%s: Wrong desired capacity; got: %v, want: %d", test.name, desired, test.wantDesired)
+		}
+		if !reflect.DeepEqual(asgKilled, test.wantASGKilled) {
+			t.Errorf("%s: Wrong terminated surged instances; got: %v, want: %v", test.name, asgKilled, test.wantASGKilled)
+		}
+		killed := 0
+		for _, c := range ec2Killed {
+			killed += len(c)
+		}
+		if killed != test.wantEC2Killed {
+			t.Errorf("%s: Wrong terminated not surged instances; got: %d, want: %d", test.name, killed, test.wantEC2Killed)
+		}
+
+		// Only the newly surged instances are tagged, the restored ones are untagged
+		_, tagged0 := tagged["i-0"][surgeTagKey]
+		if raised := test.surgeTag == "" && test.desired+2 <= test.max; tagged0 != raised {
+			t.Errorf("%s: Wrong surge tag; got: %v", test.name, tagged)
+		}
+		if _, ok := untagged["i-0"]; ok != (test.wantError && (tagged0 || test.surgeTag != "")) {
+			t.Errorf("%s: Wrong surge tag removal; got: %v", test.name, untagged)
+		}
+	}
+}
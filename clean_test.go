@@ -111,3 +111,40 @@ func TestKilleTerminateMultiple(t *testing.T) {
 
 	}
 }
+
+func TestKillerAuditBatches(t *testing.T) {
+	// Create mock for AWS API
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2Cli := sdk.NewMockEC2API(ctrl)
+
+	// Set our mock desired result
+	terminatedCalls := []map[string]*ec2.InstanceState{}
+	awsMock.MockDescribeInstancesPagesQ(t, mockEC2Cli, 10, 0)
+	awsMock.MockTerminateInstances(t, mockEC2Cli, &terminatedCalls)
+
+	auditor := &testAuditor{}
+	k := &Killer{
+		clusterName: "test",
+		markTag:     MarkTag{"key", "value"},
+		step:        50,
+		auditor:     auditor,
+	}
+	k.ec2Cli = mockEC2Cli
+
+	if err := k.Clean(); err != nil {
+		t.Errorf("Clean shouldn't give an error: %s", err)
+	}
+
+	if len(auditor.records) != 10 {
+		t.Fatalf("Wrong number of audit records; got: %d, want: %d", len(auditor.records), 10)
+	}
+	for i, r := range auditor.records {
+		if r.Action != auditActionTerminateInstances {
+			t.Errorf("Wrong audit action; got: %s, want: %s", r.Action, auditActionTerminateInstances)
+		}
+		if want := i/5 + 1; r.Evidence.Batch != want {
+			t.Errorf("Wrong audit batch; got: %d, want: %d", r.Evidence.Batch, want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// commandResult is the result of an external command that finished
+type commandResult struct {
+	stdout string
+	stderr string
+	code   int
+}
+
+// runCommand runs an external command with the stdin, it returns an error when the command
+// can't run or doesn't finish on the timeout, a non zero exit code isn't an error. The command
+// runs on its own process group, on timeout the whole group is killed so the children of a
+// shell don't keep it running
+func runCommand(command []string, timeout time.Duration, stdin []byte) (*commandResult, error) {
+	if len(command) == 0 {
+		return nil, fmt.Errorf("missing command")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(timeout):
+		// The negative pid is the process group
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+		return nil, fmt.Errorf("command timed out after %s", timeout)
+	}
+
+	res := &commandResult{
+		stdout: stdout.String(),
+		stderr: stderr.String(),
+	}
+	if err != nil {
+		exitErr, ok := err.(*exec.ExitError)
+		if !ok {
+			return nil, err
+		}
+		// ExitError.ExitCode isn't available on the Go version of the build image
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			res.code = status.ExitStatus()
+		} else {
+			res.code = 1
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRunCommand(t *testing.T) {
+	tests := []struct {
+		command    []string
+		stdin      string
+		timeout    time.Duration
+		wantStdout string
+		wantCode   int
+		wantErr    bool
+	}{
+		{[]string{"sh", "-c", "cat"}, "input", time.Second, "input", 0, false},
+		{[]string{"sh", "-c", "echo out; exit 3"}, "", time.Second, "out\n", 3, false},
+		{[]string{"missing-ecs-watcher-command"}, "", time.Second, "", 0, true},
+		{[]string{}, "", time.Second, "", 0, true},
+		// The shell children are killed with it
+		{[]string{"sh", "-c", "sleep 5; true"}, "", 100 * time.Millisecond, "", 0, true},
+	}
+
+	for _, test := range tests {
+		start := time.Now()
+		res, err := runCommand(test.command, test.timeout, []byte(test.stdin))
+		if elapsed := time.Since(start); elapsed > test.timeout+time.Second {
+			t.Errorf("%v: command should finish on the timeout, it took %s", test.command, elapsed)
+		}
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%v: run should give an error, it didn't", test.command)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: run shouldn't give an error, it did: %s", test.command, err)
+			continue
+		}
+		if res.stdout != test.wantStdout {
+			t.Errorf("%v: wrong stdout, got: %q, want: %q", test.command, res.stdout, test.wantStdout)
+		}
+		if res.code != test.wantCode {
+			t.Errorf("%v: wrong exit code, got: %d, want: %d", test.command, res.code, test.wantCode)
+		}
+	}
+}
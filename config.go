@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 )
 
@@ -17,8 +18,65 @@ const (
 	defaultStepPercent   = 20
 	defaultUnhealthyTag  = "unhealthy:true"
 	defaultDisableGC     = false
+	defaultAuditLog      = ""
+	defaultLogFormat     = logFormatText
+	defaultCheckers      = agentConnectedCheckerName
+	defaultCheckersMode  = checkModeAny
+	defaultCleaner       = killerCleanerName
+	defaultEscalation    = terminateRemediatorName
+
+	defaultEscalationStepTimeout = 5 * time.Minute
+	defaultRebootWindow          = 5 * time.Minute
+	defaultRebootMax             = 3
+	defaultRebootPeriod          = 24 * time.Hour
+	defaultSSMDocument           = "AWS-RunShellScript"
+	defaultSSMCommands           = "stop ecs;service docker restart;start ecs"
+	defaultSSMTimeout            = 2 * time.Minute
+	defaultSSMWindow             = 5 * time.Minute
+
+	defaultAgentMinVersion      = ""
+	defaultDockerMinVersion     = ""
+	defaultAgentUpdateBatch     = 5
+	defaultAgentUpdateTimeout   = 10 * time.Minute
+	defaultAgentStagingAfter    = 30 * time.Minute
+	defaultStaleInterval        = 5 * time.Minute
+	defaultStatusAfter          = 10 * time.Minute
+	defaultEC2StatusAfter       = 3 * time.Minute
+	defaultDrainTimeout         = time.Hour
+	defaultPendingAfter         = 5 * time.Minute
+	defaultTaskFailuresMax      = 3
+	defaultTaskFailuresWindow   = 15 * time.Minute
+	defaultTaskFailuresReasons  = "CannotPullContainerError,CannotStartContainerError,no space left on device"
+	defaultResourcesMemoryRatio = 0.8
+	defaultOrphansGrace         = 15 * time.Minute
+	defaultELBAfter             = 2 * time.Minute
+	defaultELBGuardPercent      = 50
+	defaultExecTimeout          = 10 * time.Second
+	defaultExecConcurrency      = 5
+	defaultExecFailurePolicy    = execFailureIgnore
+	defaultGCHookTimeout        = time.Minute
+	defaultGCHookRetry          = 5 * time.Minute
+	defaultForensicsTimeout     = 5 * time.Minute
+	defaultQuarantineRetention  = 7 * 24 * time.Hour
+	defaultQuarantineReplace    = 15 * time.Minute
+	defaultGCSurgeTimeout       = 15 * time.Minute
+	defaultGCCapacityTimeout    = 15 * time.Minute
+	defaultGCCapacityExpiry     = 6 * time.Hour
+	defaultGCELBDeregister      = true
+
+	// SSM doesn't allow commands with less timeout
+	minSSMTimeout = 30 * time.Second
 )
 
+// auditCommand is the subcommand used to query the audit log
+const auditCommand = "audit"
+
+// reconcileCommand is the subcommand used to reconcile the autoscaling groups and the cluster
+const reconcileCommand = "reconcile"
+
+// The default CloudWatch namespace of the metrics
+const defaultMetricsNamespace = "ECSWatcher"
+
 // Config represents the main configuration
 type Config struct {
 	fs *flag.FlagSet
@@ -32,80 +90,760 @@ type Config struct {
 	unhealthyTag  string
 	markAfter     time.Duration
 	disableGC     bool
+	auditLog      string
+	logFormat     string
+	checkers      []string
+	checkersMode  string
+	gcCleaners    []string
+	gcEscalation  escalationChain
+	rebootWindow  time.Duration
+	rebootMax     int
+	rebootPeriod  time.Duration
+	ssmDocument   string
+	ssmCommands   []string
+	ssmTimeout    time.Duration
+	ssmWindow     time.Duration
+
+	agentMinVersion    string
+	dockerMinVersion   string
+	agentUpdateBatch   int
+	agentUpdateTimeout time.Duration
+	agentStagingAfter  time.Duration
+	statusAfter        time.Duration
+	ec2StatusAfter     time.Duration
+	drainTimeout       time.Duration
+	pendingAfter       time.Duration
+
+	taskFailuresMax     int
+	taskFailuresWindow  time.Duration
+	taskFailuresReasons []string
+
+	resourcesMemoryRatio float64
+
+	attributesRequired requiredAttributeList
+	attributesGroup    string
+
+	orphansASGs  []string
+	orphansTag   string
+	orphansGrace time.Duration
+
+	staleDryRun   bool
+	staleInterval time.Duration
+
+	elbAfter        time.Duration
+	elbGuardPercent int
+
+	execCommand       string
+	execTimeout       time.Duration
+	execConcurrency   int
+	execFailurePolicy string
+
+	gcHookPre     string
+	gcHookPost    string
+	gcHookTimeout time.Duration
+	gcHookRetry   time.Duration
+
+	forensicsDir        string
+	forensicsS3         string
+	forensicsSnapshots  bool
+	forensicsTimeout    time.Duration
+	forensicsBestEffort bool
+
+	quarantineGroup     string
+	quarantineRetention time.Duration
+	quarantineReplace   time.Duration
+
+	gcSurge        bool
+	gcSurgeTimeout time.Duration
+
+	gcCapacityTimeout   time.Duration
+	gcCapacityExpiry    time.Duration
+	gcCapacityNamespace string
+
+	gcELBDeregister bool
 }
 
-var gCfg = Config{}
+// AuditConfig represents the audit subcommand configuration
+type AuditConfig struct {
+	fs *flag.FlagSet
 
-// init will load all the cmd flags
-func init() {
-	gCfg.fs = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	auditLog   string
+	instanceID string
+	since      string
+	until      string
+
+	query AuditQuery
+}
+
+// ReconcileConfig represents the reconcile subcommand configuration
+type ReconcileConfig struct {
+	fs *flag.FlagSet
+
+	awsRegion        string
+	clusterName      string
+	asgs             []string
+	metrics          bool
+	metricsNamespace string
+}
 
-	gCfg.fs.StringVar(
-		&gCfg.clusterName, "cluster", "",
+var gCfg = newConfig()
+var gAuditCfg = newAuditConfig()
+var gReconcileCfg = newReconcileConfig()
+
+// newConfig creates a Config with its cmd flags loaded
+func newConfig() *Config {
+	c := &Config{}
+	c.fs = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	c.fs.StringVar(
+		&c.clusterName, "cluster", "",
 		"The target cluster name",
 	)
 
-	gCfg.fs.StringVar(
-		&gCfg.awsRegion, "region", "",
+	c.fs.StringVar(
+		&c.awsRegion, "region", "",
 		"The AWS region of the cluster",
 	)
 
-	gCfg.fs.DurationVar(
-		&gCfg.checkInterval, "check.interval", defaultCheckInterval,
+	c.fs.DurationVar(
+		&c.checkInterval, "check.interval", defaultCheckInterval,
 		"The interval for checking the cluster",
 	)
 
-	gCfg.fs.DurationVar(
-		&gCfg.gcInterval, "gc.interval", defaultGCInterval,
+	c.fs.Var(
+		(*stringList)(&c.checkers), "checkers",
+		fmt.Sprintf("Comma separated list of checkers to run, available: %s (default %q)", strings.Join(checkerNames(), ","), defaultCheckers),
+	)
+
+	c.fs.StringVar(
+		&c.checkersMode, "checkers.mode", defaultCheckersMode,
+		"How the checkers verdicts are combined, any or all of them need to declare an instance unhealthy",
+	)
+
+	c.fs.StringVar(
+		&c.agentMinVersion, "agent.min.version", defaultAgentMinVersion,
+		"The minimum ECS agent version, older ones are unhealthy for the outdated-agent checker",
+	)
+
+	c.fs.StringVar(
+		&c.dockerMinVersion, "docker.min.version", defaultDockerMinVersion,
+		"The minimum docker version, older ones are unhealthy for the outdated-agent checker",
+	)
+
+	c.fs.DurationVar(
+		&c.statusAfter, "status.after", defaultStatusAfter,
+		"The duration that a target needs to be on a non active status other than DRAINING to be marked, used by the status checker instead of unhealthy.after",
+	)
+
+	c.fs.DurationVar(
+		&c.ec2StatusAfter, "ec2.status.after", defaultEC2StatusAfter,
+		"The duration that a target needs to fail the EC2 status checks to be marked, used by the ec2-status checker instead of unhealthy.after",
+	)
+
+	c.fs.DurationVar(
+		&c.drainTimeout, "drain.timeout", defaultDrainTimeout,
+		"The time a target can be draining before the replace cleaner terminates it with its live tasks, 0 waits until it doesn't have live tasks",
+	)
+
+	c.fs.DurationVar(
+		&c.pendingAfter, "pending.after", defaultPendingAfter,
+		"The duration that a target needs to have pending tasks without running more to be marked, used by the pending-tasks checker instead of unhealthy.after",
+	)
+
+	c.fs.IntVar(
+		&c.taskFailuresMax, "task.failures.max", defaultTaskFailuresMax,
+		"The failed tasks allowed on a target in the window, more are unhealthy for the task-failures checker",
+	)
+
+	c.fs.DurationVar(
+		&c.taskFailuresWindow, "task.failures.window", defaultTaskFailuresWindow,
+		"The window where the failed tasks of a target are counted by the task-failures checker",
+	)
+
+	c.fs.Var(
+		(*stringList)(&c.taskFailuresReasons), "task.failures.reasons",
+		fmt.Sprintf("Comma separated list of the stop reasons that are task failures for the task-failures checker (default %q)", defaultTaskFailuresReasons),
+	)
+
+	c.fs.Float64Var(
+		&c.resourcesMemoryRatio, "resources.memory.ratio", defaultResourcesMemoryRatio,
+		"The minimum ratio of the instance type memory a target needs to register, less is unhealthy for the resources checker",
+	)
+
+	c.fs.Var(
+		&c.attributesRequired, "attributes.required",
+		"Comma separated list of [group:]name[=value] container instance attributes required by the attributes checker",
+	)
+
+	c.fs.StringVar(
+		&c.attributesGroup, "attributes.group", "",
+		"The container instance attribute with the group of the target, the required attributes of a group only apply to its targets",
+	)
+
+	c.fs.Var(
+		(*stringList)(&c.orphansASGs), "orphans.asgs",
+		"Comma separated list of the autoscaling groups of the cluster used by the orphans checker",
+	)
+
+	c.fs.StringVar(
+		&c.orphansTag, "orphans.tag", "",
+		"The tag of the cluster instances used by the orphans checker, key:value form",
+	)
+
+	c.fs.DurationVar(
+		&c.orphansGrace, "orphans.grace", defaultOrphansGrace,
+		"The time a launched target has to register on the cluster before the orphans checker declares it unhealthy",
+	)
+
+	c.fs.DurationVar(
+		&c.elbAfter, "elb.after", defaultELBAfter,
+		"The duration that a target needs to be out of service on all its ELBs to be marked, used by the elb checker instead of unhealthy.after",
+	)
+
+	c.fs.IntVar(
+		&c.elbGuardPercent, "elb.guard.percent", defaultELBGuardPercent,
+		"The elb checker ignores the ELBs with more than this percent of their targets out of service, that's a service problem instead of a target one",
+	)
+
+	c.fs.StringVar(
+		&c.execCommand, "exec.command", "",
+		"The command run by the exec checker for each target, it receives the target JSON description on stdin",
+	)
+
+	c.fs.DurationVar(
+		&c.execTimeout, "exec.timeout", defaultExecTimeout,
+		"The maximum time the exec checker command can run for a target",
+	)
+
+	c.fs.IntVar(
+		&c.execConcurrency, "exec.concurrency", defaultExecConcurrency,
+		"The maximum exec checker commands running at the same time",
+	)
+
+	c.fs.StringVar(
+		&c.execFailurePolicy, "exec.failure.policy", defaultExecFailurePolicy,
+		fmt.Sprintf("What the exec checker does with a target when its command fails: %s, %s or %s", execFailureIgnore, execFailureUnhealthy, execFailureError),
+	)
+
+	c.fs.DurationVar(
+		&c.gcInterval, "gc.interval", defaultGCInterval,
 		"The minimum interval for garbage collection of unhealthy targets",
 	)
 
-	gCfg.fs.DurationVar(
-		&gCfg.markAfter, "unhealthy.after", defaultMarkAfter,
+	c.fs.StringVar(
+		&c.gcHookPre, "gc.hook.pre", "",
+		"The command run before terminating each batch, it receives the batch JSON description on stdin, a non zero exit code vetoes the batch and the remaining ones and 75 delays them",
+	)
+
+	c.fs.StringVar(
+		&c.gcHookPost, "gc.hook.post", "",
+		"The command run after terminating each batch, it receives the batch JSON description with the outcome on stdin",
+	)
+
+	c.fs.DurationVar(
+		&c.gcHookTimeout, "gc.hook.timeout", defaultGCHookTimeout,
+		"The maximum time a kill hook can run, a timed out pre kill hook vetoes the batch",
+	)
+
+	c.fs.DurationVar(
+		&c.gcHookRetry, "gc.hook.retry", defaultGCHookRetry,
+		"The time after a vetoed or delayed batch before running the pre kill hook again, a delay can set its own retry_after",
+	)
+
+	c.fs.StringVar(
+		&c.forensicsDir, "forensics.dir", "",
+		"The local directory where the console output and descriptions of the targets are stored before terminating them",
+	)
+
+	c.fs.StringVar(
+		&c.forensicsS3, "forensics.s3", "",
+		"The bucket[/prefix] S3 location where the console output and descriptions of the targets are stored before terminating them",
+	)
+
+	c.fs.BoolVar(
+		&c.forensicsSnapshots, "forensics.snapshots", false,
+		"Snapshot the EBS volumes attached to the targets before terminating them",
+	)
+
+	c.fs.BoolVar(
+		&c.forensicsBestEffort, "forensics.best.effort", false,
+		"Terminate the targets whose forensic capture failed instead of keeping them marked until it succeeds",
+	)
+
+	c.fs.DurationVar(
+		&c.forensicsTimeout, "forensics.timeout", defaultForensicsTimeout,
+		"The maximum time of the forensic capture of a batch, after it the batch is terminated",
+	)
+
+	c.fs.StringVar(
+		&c.quarantineGroup, "quarantine.group", "",
+		"The security group ID that replaces the security groups of the targets quarantined by the quarantine cleaner",
+	)
+
+	c.fs.DurationVar(
+		&c.quarantineRetention, "quarantine.retention", defaultQuarantineRetention,
+		"The time the targets are quarantined before terminating them, 0 keeps them forever",
+	)
+
+	c.fs.DurationVar(
+		&c.quarantineReplace, "quarantine.replace.timeout", defaultQuarantineReplace,
+		"The maximum time since the last quarantine waiting for the autoscaling groups to have their desired capacity in service before the next batch, after it the collections give an error while they are short, 0 doesn't wait",
+	)
+
+	c.fs.BoolVar(
+		&c.gcSurge, "gc.surge", false,
+		"Raise the autoscaling groups capacity by the batch size and wait for the replacements before terminating each batch",
+	)
+
+	c.fs.DurationVar(
+		&c.gcSurgeTimeout, "gc.surge.timeout", defaultGCSurgeTimeout,
+		"The maximum time since the raise waiting for the surge replacements, after it the raised capacity is restored and the batch isn't terminated",
+	)
+
+	c.fs.DurationVar(
+		&c.gcCapacityTimeout, "gc.capacity.timeout", defaultGCCapacityTimeout,
+		"The maximum time waiting for the cluster connected targets to recover between batches, after it the remaining batches are aborted and no batch starts until they recover, 0 doesn't wait",
+	)
+
+	c.fs.DurationVar(
+		&c.gcCapacityExpiry, "gc.capacity.expiry", defaultGCCapacityExpiry,
+		"The maximum time the capacity gate stays tripped, after it the batches start again even if the capacity didn't recover, 0 keeps it until the capacity recovers",
+	)
+
+	c.fs.StringVar(
+		&c.gcCapacityNamespace, "gc.capacity.namespace", defaultMetricsNamespace,
+		"The CloudWatch namespace of the CapacityGateTripped alert metric, 1 when the capacity gate aborts the batches and 0 when the capacity recovers",
+	)
+
+	c.fs.BoolVar(
+		&c.gcELBDeregister, "gc.elb.deregister", defaultGCELBDeregister,
+		"Deregister the instances from their classic ELBs and wait the connection draining before terminating them, needs the ELB describe and deregister permissions",
+	)
+
+	c.fs.DurationVar(
+		&c.markAfter, "unhealthy.after", defaultMarkAfter,
 		"The duration that a target needs to be unhealthy to declare as unhealthy",
 	)
 
-	gCfg.fs.IntVar(
-		&gCfg.gcStepPercent, "gc.step.percent", defaultStepPercent,
+	c.fs.IntVar(
+		&c.gcStepPercent, "gc.step.percent", defaultStepPercent,
 		"The step percent of total unhealthy targets when cleaning",
 	)
 
-	gCfg.fs.StringVar(
-		&gCfg.unhealthyTag, "unhealthy.tag", defaultUnhealthyTag,
+	c.fs.Var(
+		(*stringList)(&c.gcCleaners), "gc.cleaner",
+		fmt.Sprintf("Comma separated list of cleaners run in order on each collection, available: %s (default %q)", strings.Join(cleanerNames(), ","), defaultCleaner),
+	)
+
+	c.fs.BoolVar(
+		&c.staleDryRun, "stale.dry.run", false,
+		"Only log the stale container instances found by the stale cleaner, don't deregister them",
+	)
+
+	c.fs.DurationVar(
+		&c.staleInterval, "stale.interval", defaultStaleInterval,
+		"The time between the runs of the stale cleaner, each run deregisters a batch of gc.step.percent of the cluster",
+	)
+
+	c.fs.Var(
+		&c.gcEscalation, "gc.escalation",
+		fmt.Sprintf("Comma separated escalation chain of name[:timeout] steps used by the escalation cleaner, available: %s (default %q)", strings.Join(remediatorNames(), ","), defaultEscalation),
+	)
+
+	c.fs.DurationVar(
+		&c.rebootWindow, "reboot.window", defaultRebootWindow,
+		"The time that a rebooted target has to connect the agent again before terminating it, used by the reboot cleaner",
+	)
+
+	c.fs.IntVar(
+		&c.rebootMax, "reboot.max", defaultRebootMax,
+		"The maximum reboots of a target in the reboot period before terminating it",
+	)
+
+	c.fs.DurationVar(
+		&c.rebootPeriod, "reboot.period", defaultRebootPeriod,
+		"The period where the reboots of a target are counted",
+	)
+
+	c.fs.StringVar(
+		&c.ssmDocument, "ssm.document", defaultSSMDocument,
+		"The SSM document run on the targets by the restart-agent cleaner",
+	)
+
+	c.fs.Var(
+		(*commandList)(&c.ssmCommands), "ssm.commands",
+		fmt.Sprintf("Semicolon separated commands passed to the SSM document, empty to not pass them (default %q)", defaultSSMCommands),
+	)
+
+	c.fs.DurationVar(
+		&c.ssmTimeout, "ssm.timeout", defaultSSMTimeout,
+		"The time the SSM document has to finish on the targets",
+	)
+
+	c.fs.DurationVar(
+		&c.ssmWindow, "ssm.window", defaultSSMWindow,
+		"The time that a restarted target has to connect the agent again before terminating it, used by the restart-agent cleaner",
+	)
+
+	c.fs.IntVar(
+		&c.agentUpdateBatch, "agent.update.batch", defaultAgentUpdateBatch,
+		"The number of targets updating the ECS agent at the same time",
+	)
+
+	c.fs.DurationVar(
+		&c.agentUpdateTimeout, "agent.update.timeout", defaultAgentUpdateTimeout,
+		"The time an ECS agent update has to finish before rechecking the target",
+	)
+
+	c.fs.DurationVar(
+		&c.agentStagingAfter, "agent.update.staging.after", defaultAgentStagingAfter,
+		"The duration that an ECS agent update needs to be staging to be marked, used by the outdated-agent checker instead of unhealthy.after",
+	)
+
+	c.fs.StringVar(
+		&c.unhealthyTag, "unhealthy.tag", defaultUnhealthyTag,
 		"The tag used to mark unhealty labels key:value form",
 	)
 
-	gCfg.fs.BoolVar(
-		&gCfg.debug, "debug", defaultDebug,
+	c.fs.BoolVar(
+		&c.debug, "debug", defaultDebug,
 		"Run in debug mode",
 	)
 
-	gCfg.fs.BoolVar(
-		&gCfg.disableGC, "disable.gc", defaultDisableGC,
+	c.fs.StringVar(
+		&c.logFormat, "log.format", defaultLogFormat,
+		"The format of the logs, text or json",
+	)
+
+	c.fs.BoolVar(
+		&c.disableGC, "disable.gc", defaultDisableGC,
 		"Don't run garbage collector",
 	)
+
+	c.fs.StringVar(
+		&c.auditLog, "audit.log", defaultAuditLog,
+		"The file where the automated actions are audited as JSON lines, '-' for stdout",
+	)
+
+	return c
+}
+
+// newAuditConfig creates an AuditConfig with the audit subcommand flags loaded
+func newAuditConfig() *AuditConfig {
+	c := &AuditConfig{}
+	c.fs = flag.NewFlagSet(fmt.Sprintf("%s %s", os.Args[0], auditCommand), flag.ContinueOnError)
+
+	c.fs.StringVar(
+		&c.auditLog, "audit.log", defaultAuditLog,
+		"The audit log file to query, '-' for stdin",
+	)
+
+	c.fs.StringVar(
+		&c.instanceID, "instance", "",
+		"Only show the records of this instance ID",
+	)
+
+	c.fs.StringVar(
+		&c.since, "since", "",
+		"Only show the records from this time in RFC3339 format",
+	)
+
+	c.fs.StringVar(
+		&c.until, "until", "",
+		"Only show the records until this time in RFC3339 format",
+	)
+
+	return c
 }
 
-func parse(args []string) error {
+// newReconcileConfig creates a ReconcileConfig with the reconcile subcommand flags loaded
+func newReconcileConfig() *ReconcileConfig {
+	c := &ReconcileConfig{}
+	c.fs = flag.NewFlagSet(fmt.Sprintf("%s %s", os.Args[0], reconcileCommand), flag.ContinueOnError)
+
+	c.fs.StringVar(
+		&c.clusterName, "cluster", "",
+		"The target cluster name",
+	)
+
+	c.fs.StringVar(
+		&c.awsRegion, "region", "",
+		"The AWS region of the cluster",
+	)
+
+	c.fs.Var(
+		(*stringList)(&c.asgs), "asgs",
+		"Comma separated list of the autoscaling groups of the cluster",
+	)
 
-	if err := gCfg.fs.Parse(args); err != nil {
+	c.fs.BoolVar(
+		&c.metrics, "metrics", false,
+		"Publish the report as CloudWatch metrics",
+	)
+
+	c.fs.StringVar(
+		&c.metricsNamespace, "metrics.namespace", defaultMetricsNamespace,
+		"The CloudWatch namespace of the metrics",
+	)
+
+	return c
+}
+
+// parse parses the cmd flags and validates the configuration
+func (c *Config) parse(args []string) error {
+	if err := c.fs.Parse(args); err != nil {
 		return err
 	}
-	match, err := regexp.MatchString(`^[^:]+:[^:]+$`, gCfg.unhealthyTag)
+	match, err := regexp.MatchString(`^[^:]+:[^:]+$`, c.unhealthyTag)
 	if !match || err != nil {
 		return fmt.Errorf("Wrong tag format, must be key:value format. Help: %s -h", os.Args[0])
 	}
 
-	if gCfg.awsRegion == "" {
+	if c.orphansTag != "" {
+		match, err := regexp.MatchString(`^[^:]+:[^:]+$`, c.orphansTag)
+		if !match || err != nil {
+			return fmt.Errorf("Wrong orphans tag format, must be key:value format. Help: %s -h", os.Args[0])
+		}
+	}
+
+	if c.logFormat != logFormatText && c.logFormat != logFormatJSON {
+		return fmt.Errorf("Wrong log format, must be %s or %s. Help: %s -h", logFormatText, logFormatJSON, os.Args[0])
+	}
+
+	if len(c.checkers) == 0 {
+		c.checkers = []string{defaultCheckers}
+	}
+	for _, c := range c.checkers {
+		if _, ok := checkerRegistry[c]; !ok {
+			return fmt.Errorf("Unknown %s checker. Help: %s -h", c, os.Args[0])
+		}
+	}
+
+	if c.checkersMode != checkModeAny && c.checkersMode != checkModeAll {
+		return fmt.Errorf("Wrong checkers mode, must be %s or %s. Help: %s -h", checkModeAny, checkModeAll, os.Args[0])
+	}
+
+	if len(c.gcCleaners) == 0 {
+		c.gcCleaners = []string{defaultCleaner}
+	}
+	for _, c := range c.gcCleaners {
+		if _, ok := cleanerRegistry[c]; !ok {
+			return fmt.Errorf("Unknown %s cleaner. Help: %s -h", c, os.Args[0])
+		}
+	}
+
+	if len(c.gcEscalation) == 0 {
+		c.gcEscalation.Set(defaultEscalation)
+	}
+	for _, s := range c.gcEscalation {
+		if _, ok := remediatorRegistry[s.name]; !ok {
+			return fmt.Errorf("Unknown %s escalation step. Help: %s -h", s.name, os.Args[0])
+		}
+	}
+
+	if c.ssmCommands == nil {
+		(*commandList)(&c.ssmCommands).Set(defaultSSMCommands)
+	}
+
+	if c.ssmTimeout < minSSMTimeout {
+		return fmt.Errorf("SSM timeout can't be less than %s. Help: %s -h", minSSMTimeout, os.Args[0])
+	}
+
+	if c.ec2StatusAfter <= 0 {
+		return fmt.Errorf("EC2 status after must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.staleInterval < 0 {
+		return fmt.Errorf("Stale interval can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.agentStagingAfter <= 0 {
+		return fmt.Errorf("Agent update staging after must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.elbAfter <= 0 {
+		return fmt.Errorf("ELB after must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.elbGuardPercent <= 0 || c.elbGuardPercent > 100 {
+		return fmt.Errorf("ELB guard percent must be between 1 and 100. Help: %s -h", os.Args[0])
+	}
+
+	if c.gcCapacityTimeout < 0 {
+		return fmt.Errorf("GC capacity timeout can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.gcCapacityExpiry < 0 {
+		return fmt.Errorf("GC capacity expiry can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.gcCapacityNamespace == "" {
+		return fmt.Errorf("GC capacity namespace can't be empty. Help: %s -h", os.Args[0])
+	}
+
+	if c.gcSurgeTimeout <= 0 {
+		return fmt.Errorf("GC surge timeout must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.quarantineRetention < 0 {
+		return fmt.Errorf("Quarantine retention can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.quarantineReplace < 0 {
+		return fmt.Errorf("Quarantine replace timeout can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.forensicsDir != "" && c.forensicsS3 != "" {
+		return fmt.Errorf("Forensics can't be stored on a directory and S3 at the same time. Help: %s -h", os.Args[0])
+	}
+
+	if c.forensicsTimeout <= 0 {
+		return fmt.Errorf("Forensics timeout must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.gcHookTimeout <= 0 {
+		return fmt.Errorf("GC hook timeout must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.gcHookRetry <= 0 {
+		return fmt.Errorf("GC hook retry must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.execTimeout <= 0 {
+		return fmt.Errorf("Exec timeout must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.execConcurrency <= 0 {
+		return fmt.Errorf("Exec concurrency must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	switch c.execFailurePolicy {
+	case execFailureIgnore, execFailureUnhealthy, execFailureError:
+	default:
+		return fmt.Errorf("Wrong exec failure policy, must be %s, %s or %s. Help: %s -h", execFailureIgnore, execFailureUnhealthy, execFailureError, os.Args[0])
+	}
+
+	if c.drainTimeout < 0 {
+		return fmt.Errorf("Drain timeout can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.taskFailuresReasons == nil {
+		(*stringList)(&c.taskFailuresReasons).Set(defaultTaskFailuresReasons)
+	}
+
+	if c.taskFailuresMax < 0 {
+		return fmt.Errorf("Task failures max can't be negative. Help: %s -h", os.Args[0])
+	}
+
+	if c.resourcesMemoryRatio < 0 || c.resourcesMemoryRatio > 1 {
+		return fmt.Errorf("Resources memory ratio must be between 0 and 1. Help: %s -h", os.Args[0])
+	}
+
+	if c.agentUpdateBatch <= 0 {
+		return fmt.Errorf("Agent update batch must be greater than 0. Help: %s -h", os.Args[0])
+	}
+
+	if c.awsRegion == "" {
 		return fmt.Errorf("Cluster AWS region must be set. Help: %s -h", os.Args[0])
 	}
 
-	if gCfg.clusterName == "" {
+	if c.clusterName == "" {
 		return fmt.Errorf("Cluster name can't be empty. Help: %s -h", os.Args[0])
 	}
-	if len(gCfg.fs.Args()) != 0 {
+	if len(c.fs.Args()) != 0 {
 		return fmt.Errorf("Invalid command line arguments. Help: %s -h", os.Args[0])
 
 	}
 	return nil
 }
+
+// parse parses the audit subcommand flags and builds its query
+func (c *AuditConfig) parse(args []string) error {
+	if err := c.fs.Parse(args); err != nil {
+		return err
+	}
+
+	if c.auditLog == "" {
+		return fmt.Errorf("Audit log must be set. Help: %s %s -h", os.Args[0], auditCommand)
+	}
+
+	q := AuditQuery{InstanceID: c.instanceID}
+	if c.since != "" {
+		t, err := time.Parse(time.RFC3339, c.since)
+		if err != nil {
+			return fmt.Errorf("Wrong since time format, must be RFC3339. Help: %s %s -h", os.Args[0], auditCommand)
+		}
+		q.Since = t
+	}
+	if c.until != "" {
+		t, err := time.Parse(time.RFC3339, c.until)
+		if err != nil {
+			return fmt.Errorf("Wrong until time format, must be RFC3339. Help: %s %s -h", os.Args[0], auditCommand)
+		}
+		q.Until = t
+	}
+	c.query = q
+
+	if len(c.fs.Args()) != 0 {
+		return fmt.Errorf("Invalid command line arguments. Help: %s %s -h", os.Args[0], auditCommand)
+	}
+	return nil
+}
+
+// stringList is a comma separated list flag
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(v string) error {
+	*s = []string{}
+	for _, i := range strings.Split(v, ",") {
+		if i = strings.TrimSpace(i); i != "" {
+			*s = append(*s, i)
+		}
+	}
+	return nil
+}
+
+// commandList is a semicolon separated list flag
+type commandList []string
+
+func (c *commandList) String() string {
+	return strings.Join(*c, ";")
+}
+
+func (c *commandList) Set(v string) error {
+	*c = []string{}
+	for _, i := range strings.Split(v, ";") {
+		if i = strings.TrimSpace(i); i != "" {
+			*c = append(*c, i)
+		}
+	}
+	return nil
+}
+
+// parse parses the reconcile subcommand flags and validates them
+func (c *ReconcileConfig) parse(args []string) error {
+	if err := c.fs.Parse(args); err != nil {
+		return err
+	}
+
+	if c.awsRegion == "" {
+		return fmt.Errorf("Cluster AWS region must be set. Help: %s %s -h", os.Args[0], reconcileCommand)
+	}
+
+	if c.clusterName == "" {
+		return fmt.Errorf("Cluster name can't be empty. Help: %s %s -h", os.Args[0], reconcileCommand)
+	}
+
+	if len(c.asgs) == 0 {
+		return fmt.Errorf("Autoscaling groups must be set. Help: %s %s -h", os.Args[0], reconcileCommand)
+	}
+
+	if len(c.fs.Args()) != 0 {
+		return fmt.Errorf("Invalid command line arguments. Help: %s %s -h", os.Args[0], reconcileCommand)
+	}
+	return nil
+}
@@ -1,31 +1,156 @@
 package main
 
 import (
+	"strings"
 	"testing"
 )
 
 func TestParse(t *testing.T) {
 	tests := []struct {
-		args    []string
-		correct bool
+		args []string
+		err  string
 	}{
-		{[]string{"--debug"}, false},
-		{[]string{"-debug", "--region", "eu-west-1"}, false},
-		{[]string{"--region", "eu-west-1", "-cluster", "test"}, true},
-		{[]string{"--region", "eu-west-1", "-cluster", "test", "-unhealthy.tag", "key-value"}, false},
-		{[]string{"--region", "eu-west-1", "-cluster", "test", "-unhealthy.tag", "key:value"}, true},
-		{[]string{"--region", "eu-west-1", "-cluster", "test", "check.interval", "1t"}, false},
+		{[]string{"--debug"}, "Cluster AWS region must be set"},
+		{[]string{"-debug", "--region", "eu-west-1"}, "Cluster name can't be empty"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-unhealthy.tag", "key-value"}, "Wrong tag format, must be key:value format"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-unhealthy.tag", "key:value"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "check.interval", "1t"}, "Invalid command line arguments"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-log.format", "json"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent", "-checkers.mode", "all"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "escalation", "-gc.escalation", "terminate:1m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "reboot", "-reboot.window", "2m", "-reboot.max", "2"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "restart-agent", "-ssm.commands", "restart ecs", "-ssm.timeout", "1m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,outdated-agent", "-agent.min.version", "1.12.0", "-gc.cleaner", "update-agent", "-agent.update.batch", "2"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,status", "-status.after", "5m", "-gc.cleaner", "killer"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,ec2-status", "-ec2.status.after", "5m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,ec2-events", "-gc.cleaner", "replace", "-drain.timeout", "30m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,pending-tasks", "-pending.after", "10m", "-gc.cleaner", "killer"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,task-failures", "-task.failures.max", "5", "-task.failures.reasons", "CannotPullContainerError"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,resources", "-resources.memory.ratio", "0.9"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,attributes", "-attributes.required", "awslogs,web:stack=prod", "-attributes.group", "group"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,orphans", "-orphans.asgs", "asg-0,asg-1", "-orphans.tag", "cluster:test", "-orphans.grace", "30m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "killer,stale", "-stale.dry.run"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,elb", "-elb.after", "5m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "exec", "-exec.command", "/usr/local/bin/probe --fast", "-exec.failure.policy", "unhealthy"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.hook.pre", "/usr/local/bin/pre-kill --notify", "-gc.hook.post", "/usr/local/bin/post-kill", "-gc.hook.timeout", "30s", "-gc.hook.retry", "10m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-forensics.s3", "bucket/forensics", "-forensics.snapshots", "-forensics.timeout", "2m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-forensics.dir", "/var/lib/ecs-watcher", "-forensics.best.effort"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "quarantine", "-quarantine.group", "sg-0f4a1b2c", "-quarantine.retention", "72h", "-quarantine.replace.timeout", "0s"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.surge", "-gc.surge.timeout", "20m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.capacity.timeout", "0s"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.capacity.namespace", "Platform/ECS"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.capacity.expiry", "0s"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.elb.deregister=false"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,outdated-agent", "-agent.update.staging.after", "1h"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "killer,stale", "-stale.interval", "1m"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent,elb", "-elb.guard.percent", "30"}, ""},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "unknown"}, "Unknown unknown cleaner"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-elb.guard.percent", "0"}, "ELB guard percent must be between 1 and 100"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-stale.interval", "-1m"}, "Stale interval can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-agent.update.staging.after", "0s"}, "Agent update staging after must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.capacity.timeout", "-1m"}, "GC capacity timeout can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.capacity.expiry", "-1m"}, "GC capacity expiry can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.surge.timeout", "0s"}, "GC surge timeout must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-quarantine.retention", "-1h"}, "Quarantine retention can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-quarantine.replace.timeout", "-1m"}, "Quarantine replace timeout can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.capacity.namespace", ""}, "GC capacity namespace can't be empty"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-forensics.dir", "/var/lib/ecs-watcher", "-forensics.s3", "bucket"}, "Forensics can't be stored on a directory and S3 at the same time"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-forensics.timeout", "0s"}, "Forensics timeout must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.hook.timeout", "0s"}, "GC hook timeout must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.hook.retry", "0s"}, "GC hook retry must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-exec.timeout", "0s"}, "Exec timeout must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-exec.concurrency", "0"}, "Exec concurrency must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-exec.failure.policy", "unknown"}, "Wrong exec failure policy, must be ignore, unhealthy or error"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-elb.after", "0s"}, "ELB after must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "killer,unknown"}, "Unknown unknown cleaner"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-orphans.tag", "cluster"}, "Wrong orphans tag format, must be key:value format"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-attributes.required", "web:"}, "wrong required attribute \"web:\", the name is missing"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-resources.memory.ratio", "1.5"}, "Resources memory ratio must be between 0 and 1"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-task.failures.max", "-1"}, "Task failures max can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "replace", "-drain.timeout", "-1m"}, "Drain timeout can't be negative"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-ec2.status.after", "0s"}, "EC2 status after must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "update-agent", "-agent.update.batch", "0"}, "Agent update batch must be greater than 0"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "restart-agent", "-ssm.timeout", "10s"}, "SSM timeout can't be less than 30s"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.cleaner", "escalation", "-gc.escalation", "unknown,terminate"}, "Unknown unknown escalation step"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-gc.escalation", "terminate", "-checkers", "agent,unknown"}, "Unknown unknown checker"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-checkers", "agent", "-checkers.mode", "some"}, "Wrong checkers mode, must be any or all"},
+		{[]string{"--region", "eu-west-1", "-cluster", "test", "-log.format", "xml"}, "Wrong log format, must be text or json"},
 	}
 
 	for _, test := range tests {
-		err := parse(test.args)
-		if err != nil && test.correct {
-			t.Errorf("- %+v\n Shouldn't give an error: %s", test, err)
+		// Each case parses a new configuration so the flags of the previous ones don't leak
+		err := newConfig().parse(test.args)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("- %+v\n Shouldn't give an error: %s", test, err)
+			}
+			continue
 		}
 
-		if err == nil && !test.correct {
+		if err == nil {
 			t.Errorf("- %+v\n Should give an error, it didn't", test)
+		} else if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("- %+v\n Should give the %q error, got: %s", test, test.err, err)
 		}
+	}
+}
+
+func TestParseAudit(t *testing.T) {
+	tests := []struct {
+		args []string
+		err  string
+	}{
+		{[]string{}, "Audit log must be set"},
+		{[]string{"-audit.log", "audit.log"}, ""},
+		{[]string{"-audit.log", "audit.log", "-instance", "i-1"}, ""},
+		{[]string{"-audit.log", "audit.log", "-since", "2016-08-10T10:00:00Z", "-until", "2016-08-11T10:00:00Z"}, ""},
+		{[]string{"-audit.log", "audit.log", "-since", "yesterday"}, "Wrong since time format, must be RFC3339"},
+		{[]string{"-audit.log", "audit.log", "extra"}, "Invalid command line arguments"},
+	}
 
+	for _, test := range tests {
+		err := newAuditConfig().parse(test.args)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("- %+v\n Shouldn't give an error: %s", test, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("- %+v\n Should give an error, it didn't", test)
+		} else if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("- %+v\n Should give the %q error, got: %s", test, test.err, err)
+		}
+	}
+}
+
+func TestParseReconcile(t *testing.T) {
+	tests := []struct {
+		args []string
+		err  string
+	}{
+		{[]string{}, "Cluster AWS region must be set"},
+		{[]string{"-region", "eu-west-1", "-cluster", "test"}, "Autoscaling groups must be set"},
+		{[]string{"-region", "eu-west-1", "-cluster", "test", "-asgs", "asg-0,asg-1"}, ""},
+		{[]string{"-region", "eu-west-1", "-cluster", "test", "-asgs", "asg-0", "-metrics", "-metrics.namespace", "Test"}, ""},
+		{[]string{"-region", "eu-west-1", "-cluster", "test", "-asgs", "asg-0", "extra"}, "Invalid command line arguments"},
+	}
+
+	for _, test := range tests {
+		err := newReconcileConfig().parse(test.args)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("- %+v\n Shouldn't give an error: %s", test, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("- %+v\n Should give an error, it didn't", test)
+		} else if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("- %+v\n Should give the %q error, got: %s", test, test.err, err)
+		}
 	}
 }
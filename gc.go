@@ -3,12 +3,12 @@ package main
 import (
 	"fmt"
 	"time"
-
-	"github.com/Sirupsen/logrus"
 )
 
 // GC  represents the garbage collector of the unhleathy targets
 type GC struct {
+	// The name of the cluster
+	clusterName string
 
 	// collection interval
 	interval time.Duration
@@ -18,17 +18,17 @@ type GC struct {
 }
 
 // NewGC creates a new garbage collector
-func NewGC(cfg Config) (*GC, error) {
+func NewGC(cfg Config, auditor Auditor) (*GC, error) {
 	gc := &GC{
-		interval: cfg.gcInterval,
+		clusterName: cfg.clusterName,
+		interval:    cfg.gcInterval,
 	}
-	// TODO: clener selection
-	k, err := NewKiller(cfg.awsRegion, cfg.gcStepPercent, cfg.unhealthyTag)
+	c, err := newCleaner(cfg, auditor)
 	if err != nil {
 		return nil, err
 	}
 
-	gc.cleaner = k
+	gc.cleaner = c
 
 	return gc, nil
 }
@@ -40,13 +40,14 @@ func (g *GC) Run() error {
 		return fmt.Errorf("No cleaner active on the garbage collector")
 	}
 
-	logrus.Infof("Starting garbage collector")
+	log := componentLog(g.clusterName, componentGC)
+	log.WithField("interval", g.interval.String()).Info("Starting garbage collector")
 	t := time.NewTicker(g.interval)
 
 	for range t.C {
 		err := g.cleaner.Clean()
 		if err != nil {
-			logrus.Errorf("Error cleaning instances: %s", err)
+			log.WithError(err).Error("Error cleaning instances")
 			continue
 		}
 	}
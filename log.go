@@ -0,0 +1,33 @@
+package main
+
+import "github.com/Sirupsen/logrus"
+
+// Log formats
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+// Structured log fields
+const (
+	logFieldCluster    = "cluster"
+	logFieldComponent  = "component"
+	logFieldInstanceID = "instance_id"
+	logFieldBatch      = "batch"
+	logFieldAction     = "action"
+)
+
+// Components of the watcher, used on logs and audit records
+const (
+	componentWatcher = "watcher"
+	componentGC      = "gc"
+	componentChecker = "checker"
+)
+
+// componentLog returns a logger with the cluster and component fields set
+func componentLog(clusterName, component string) *logrus.Entry {
+	return logrus.WithFields(logrus.Fields{
+		logFieldCluster:   clusterName,
+		logFieldComponent: component,
+	})
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -12,6 +11,11 @@ import (
 // Generate AWS API mocks running go generate
 //go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/ecs/ecsiface/interface.go -package sdk -destination ./mock/aws/sdk/ecsiface_mock.go
 //go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/ec2/ec2iface/interface.go -package sdk -destination ./mock/aws/sdk/ec2iface_mock.go
+//go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/ssm/ssmiface/interface.go -package sdk -destination ./mock/aws/sdk/ssmiface_mock.go
+//go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface/interface.go -package sdk -destination ./mock/aws/sdk/autoscalingiface_mock.go
+//go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface/interface.go -package sdk -destination ./mock/aws/sdk/cloudwatchiface_mock.go
+//go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/elb/elbiface/interface.go -package sdk -destination ./mock/aws/sdk/elbiface_mock.go
+//go:generate mockgen -source ./vendor/github.com/aws/aws-sdk-go/service/s3/s3iface/interface.go -package sdk -destination ./mock/aws/sdk/s3iface_mock.go
 
 func main() {
 	os.Exit(Main())
@@ -19,31 +23,56 @@ func main() {
 
 // Main will run the main program
 func Main() int {
+	// Run the audit query subcommand if required
+	if len(os.Args) > 1 && os.Args[1] == auditCommand {
+		return MainAudit()
+	}
+
+	// Run the reconcile subcommand if required
+	if len(os.Args) > 1 && os.Args[1] == reconcileCommand {
+		return MainReconcile()
+	}
+
 	// Parse command line flags
-	if err := parse(os.Args[1:]); err != nil {
+	if err := gCfg.parse(os.Args[1:]); err != nil {
 		logrus.Error(err)
 		return 1
 	}
-	cfg := gCfg
+	cfg := *gCfg
 
 	if cfg.debug {
 		logrus.SetLevel(logrus.DebugLevel)
 	}
 
+	if cfg.logFormat == logFormatJSON {
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	}
+
+	// Create the auditor if wanted
+	var auditor Auditor
+	if cfg.auditLog != "" {
+		a, err := NewJSONAuditor(cfg.auditLog)
+		if err != nil {
+			logrus.WithError(err).Error("Error creating auditor")
+			return 1
+		}
+		auditor = a
+	}
+
 	// Create the watcher
-	w, err := NewWatcher(cfg)
+	w, err := NewWatcher(cfg, auditor)
 	if err != nil {
-		logrus.Errorf("Error creating watcher: %s", err)
+		logrus.WithError(err).Error("Error creating watcher")
 		return 1
 	}
 
-	gc, err := NewGC(cfg)
+	gc, err := NewGC(cfg, auditor)
 	if err != nil {
-		logrus.Errorf("Error creating garbage colletor: %s", err)
+		logrus.WithError(err).Error("Error creating garbage colletor")
 		return 1
 	}
 
-	logrus.Infof("Ready to rock")
+	logrus.Info("Ready to rock")
 	errChan := make(chan error, 10)
 
 	// Start the garbage collector if wanted
@@ -52,7 +81,7 @@ func Main() int {
 			errChan <- gc.Run()
 		}()
 	} else {
-		logrus.Warningf("Garbage collector is disabled, not running it!")
+		logrus.Warning("Garbage collector is disabled, not running it!")
 	}
 
 	// Start the watcher loop
@@ -71,8 +100,68 @@ func Main() int {
 				return 1
 			}
 		case s := <-signalChan:
-			logrus.Println(fmt.Sprintf("Captured %v. Exiting...", s))
+			logrus.WithField("signal", s).Info("Captured signal, exiting")
 			return 0
 		}
 	}
 }
+
+// MainAudit will run the audit log query subcommand
+func MainAudit() int {
+	if err := gAuditCfg.parse(os.Args[2:]); err != nil {
+		logrus.Error(err)
+		return 1
+	}
+	cfg := *gAuditCfg
+
+	r := os.Stdin
+	if cfg.auditLog != auditStdout {
+		f, err := os.Open(cfg.auditLog)
+		if err != nil {
+			logrus.WithError(err).Error("Error opening audit log")
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if _, err := QueryAudit(r, os.Stdout, cfg.query); err != nil {
+		logrus.WithError(err).Error("Error querying audit log")
+		return 1
+	}
+	return 0
+}
+
+// MainReconcile will run the autoscaling groups and cluster reconcile subcommand
+func MainReconcile() int {
+	if err := gReconcileCfg.parse(os.Args[2:]); err != nil {
+		logrus.Error(err)
+		return 1
+	}
+	cfg := *gReconcileCfg
+
+	r, err := NewReconciler(cfg.clusterName, cfg.awsRegion, cfg.asgs, cfg.metricsNamespace)
+	if err != nil {
+		logrus.WithError(err).Error("Error creating reconciler")
+		return 1
+	}
+
+	report, err := r.Reconcile()
+	if err != nil {
+		logrus.WithError(err).Error("Error reconciling")
+		return 1
+	}
+
+	if err := WriteReport(os.Stdout, report); err != nil {
+		logrus.WithError(err).Error("Error writing reconcile report")
+		return 1
+	}
+
+	if cfg.metrics {
+		if err := r.PublishMetrics(report); err != nil {
+			logrus.WithError(err).Error("Error publishing reconcile metrics")
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockUpdateContainerAgent will append the updated container instances on the received slice,
+// if the error code is set the calls will return an AWS error with that code
+func MockUpdateContainerAgent(t *testing.T, mockMatcher *sdk.MockECSAPI, updated *[]string, errCode string) {
+	logrus.Warningf("Mocking AWS iface: UpdateContainerAgent")
+
+	var err error
+	if errCode != "" {
+		err = awserr.New(errCode, "", nil)
+	}
+
+	mockMatcher.EXPECT().UpdateContainerAgent(gomock.Any()).Do(
+		func(input *ecs.UpdateContainerAgentInput) {
+			*updated = append(*updated, aws.StringValue(input.ContainerInstance))
+		}).AnyTimes().Return(&ecs.UpdateContainerAgentOutput{}, err)
+}
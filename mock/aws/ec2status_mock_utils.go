@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeInstanceStatusPagesError will return error
+func MockDescribeInstanceStatusPagesError(t *testing.T, mockMatcher *sdk.MockEC2API) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstanceStatusPages")
+
+	err := errors.New("")
+	mockMatcher.EXPECT().DescribeInstanceStatusPages(gomock.Any(), gomock.Any()).AnyTimes().Return(err)
+}
+
+// MockDescribeInstanceStatusPages will return the instances of the received map whose impaired
+// check (filter name, ex: system-status.status) matches the filter of the request
+func MockDescribeInstanceStatusPages(t *testing.T, mockMatcher *sdk.MockEC2API, impaired map[string]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstanceStatusPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeInstanceStatusPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstanceStatusInput, fn func(p *ec2.DescribeInstanceStatusOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &ec2.DescribeInstanceStatusOutput{}
+			for id, filter := range impaired {
+				for _, f := range input.Filters {
+					if aws.StringValue(f.Name) == filter {
+						resp.InstanceStatuses = append(resp.InstanceStatuses, &ec2.InstanceStatus{InstanceId: aws.String(id)})
+					}
+				}
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
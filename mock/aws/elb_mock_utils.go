@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockListServicesPages will return the ARNs of the received services when calling
+func MockListServicesPages(t *testing.T, mockMatcher *sdk.MockECSAPI, services ...*ecs.Service) {
+	MockListServicesPagesCalls(t, mockMatcher, new(int), services...)
+}
+
+// MockListServicesPagesCalls will return the ARNs of the received services when calling and count
+// the calls on the received counter
+func MockListServicesPagesCalls(t *testing.T, mockMatcher *sdk.MockECSAPI, calls *int, services ...*ecs.Service) {
+	logrus.Warningf("Mocking AWS iface: ListServicesPages")
+
+	var err error
+
+	mockMatcher.EXPECT().ListServicesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ecs.ListServicesInput, fn func(p *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool)) {
+			*calls++
+			resp := &ecs.ListServicesOutput{}
+			for _, s := range services {
+				resp.ServiceArns = append(resp.ServiceArns, s.ServiceArn)
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockDescribeServices will return the requested services from the received ones
+func MockDescribeServices(t *testing.T, mockMatcher *sdk.MockECSAPI, services ...*ecs.Service) {
+	logrus.Warningf("Mocking AWS iface: DescribeServices")
+
+	byArn := map[string]*ecs.Service{}
+	for _, s := range services {
+		byArn[aws.StringValue(s.ServiceArn)] = s
+	}
+
+	// The response is filled with the requested services before returning it
+	var err error
+	resp := &ecs.DescribeServicesOutput{}
+	mockMatcher.EXPECT().DescribeServices(gomock.Any()).Do(
+		func(input *ecs.DescribeServicesInput) {
+			resp.Services = nil
+			for _, arn := range input.Services {
+				if s, ok := byArn[aws.StringValue(arn)]; ok {
+					resp.Services = append(resp.Services, s)
+				}
+			}
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockDescribeInstanceHealth will return the instance states of the requested ELB from the
+// received ones by ELB and instance
+func MockDescribeInstanceHealth(t *testing.T, mockMatcher *sdk.MockELBAPI, health map[string]map[string]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstanceHealth")
+
+	// The response is filled with the requested ELB states before returning it
+	var err error
+	resp := &elb.DescribeInstanceHealthOutput{}
+	mockMatcher.EXPECT().DescribeInstanceHealth(gomock.Any()).Do(
+		func(input *elb.DescribeInstanceHealthInput) {
+			resp.InstanceStates = nil
+			for id, state := range health[aws.StringValue(input.LoadBalancerName)] {
+				resp.InstanceStates = append(resp.InstanceStates, &elb.InstanceState{
+					InstanceId: aws.String(id),
+					State:      aws.String(state),
+				})
+			}
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockDescribeLoadBalancersPages will return the received ELBs with their registered instances
+func MockDescribeLoadBalancersPages(t *testing.T, mockMatcher *sdk.MockELBAPI, lbs map[string][]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeLoadBalancersPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeLoadBalancersPages(gomock.Any(), gomock.Any()).Do(
+		func(input *elb.DescribeLoadBalancersInput, fn func(p *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &elb.DescribeLoadBalancersOutput{}
+			for name, ids := range lbs {
+				lb := &elb.LoadBalancerDescription{LoadBalancerName: aws.String(name)}
+				for _, id := range ids {
+					lb.Instances = append(lb.Instances, &elb.Instance{InstanceId: aws.String(id)})
+				}
+				resp.LoadBalancerDescriptions = append(resp.LoadBalancerDescriptions, lb)
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockDescribeLoadBalancerAttributes will return the connection draining seconds of the requested
+// ELB from the received ones, the missing ELBs have the connection draining disabled
+func MockDescribeLoadBalancerAttributes(t *testing.T, mockMatcher *sdk.MockELBAPI, draining map[string]int64) {
+	logrus.Warningf("Mocking AWS iface: DescribeLoadBalancerAttributes")
+
+	// The response is filled with the requested ELB attributes before returning it
+	var err error
+	resp := &elb.DescribeLoadBalancerAttributesOutput{}
+	mockMatcher.EXPECT().DescribeLoadBalancerAttributes(gomock.Any()).Do(
+		func(input *elb.DescribeLoadBalancerAttributesInput) {
+			s, ok := draining[aws.StringValue(input.LoadBalancerName)]
+			resp.LoadBalancerAttributes = &elb.LoadBalancerAttributes{
+				ConnectionDraining: &elb.ConnectionDraining{
+					Enabled: aws.Bool(ok),
+					Timeout: aws.Int64(s),
+				},
+			}
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockDeregisterInstancesFromLoadBalancerError will return error
+func MockDeregisterInstancesFromLoadBalancerError(t *testing.T, mockMatcher *sdk.MockELBAPI) {
+	logrus.Warningf("Mocking AWS iface: DeregisterInstancesFromLoadBalancer")
+
+	err := errors.New("")
+
+	mockMatcher.EXPECT().DeregisterInstancesFromLoadBalancer(gomock.Any()).AnyTimes().Return(nil, err)
+}
+
+// MockDeregisterInstancesFromLoadBalancer will append the deregistered instances by ELB on the received map
+func MockDeregisterInstancesFromLoadBalancer(t *testing.T, mockMatcher *sdk.MockELBAPI, deregistered map[string][]string) {
+	logrus.Warningf("Mocking AWS iface: DeregisterInstancesFromLoadBalancer")
+
+	var err error
+
+	mockMatcher.EXPECT().DeregisterInstancesFromLoadBalancer(gomock.Any()).Do(
+		func(input *elb.DeregisterInstancesFromLoadBalancerInput) {
+			name := aws.StringValue(input.LoadBalancerName)
+			for _, i := range input.Instances {
+				deregistered[name] = append(deregistered[name], aws.StringValue(i.InstanceId))
+			}
+		}).AnyTimes().Return(nil, err)
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeInstancesPagesInstances will return the received instances when calling
+func MockDescribeInstancesPagesInstances(t *testing.T, mockMatcher *sdk.MockEC2API, instances ...*ec2.Instance) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstancesPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{
+					&ec2.Reservation{Instances: instances},
+				},
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockCreateTagsAll will set all the created tags of the instances on the received map
+func MockCreateTagsAll(t *testing.T, mockMatcher *sdk.MockEC2API, taggedInstances map[string]map[string]string) {
+	logrus.Warningf("Mocking AWS iface: CreateTags")
+	var err error
+
+	mockMatcher.EXPECT().CreateTags(gomock.Any()).Do(
+		func(input *ec2.CreateTagsInput) {
+			for _, i := range input.Resources {
+				id := aws.StringValue(i)
+				if _, ok := taggedInstances[id]; !ok {
+					taggedInstances[id] = map[string]string{}
+				}
+				for _, tag := range input.Tags {
+					taggedInstances[id][aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+				}
+			}
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockDeleteTags will set the deleted tag keys of the instances on the received map
+func MockDeleteTags(t *testing.T, mockMatcher *sdk.MockEC2API, untaggedInstances map[string][]string) {
+	logrus.Warningf("Mocking AWS iface: DeleteTags")
+	var err error
+
+	mockMatcher.EXPECT().DeleteTags(gomock.Any()).Do(
+		func(input *ec2.DeleteTagsInput) {
+			for _, i := range input.Resources {
+				id := aws.StringValue(i)
+				for _, tag := range input.Tags {
+					untaggedInstances[id] = append(untaggedInstances[id], aws.StringValue(tag.Key))
+				}
+			}
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockDescribeContainerInstances will return the received container instances
+func MockDescribeContainerInstances(t *testing.T, mockMatcher *sdk.MockECSAPI, cis ...*ecs.ContainerInstance) {
+	logrus.Warningf("Mocking AWS iface: DescribeContainerInstances")
+
+	var err error
+
+	resp := &ecs.DescribeContainerInstancesOutput{
+		ContainerInstances: cis,
+	}
+	mockMatcher.EXPECT().DescribeContainerInstances(gomock.Any()).AnyTimes().Return(resp, err)
+}
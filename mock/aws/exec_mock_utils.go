@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeInstancesPagesTags will return running instances with the received tags by instance ID
+func MockDescribeInstancesPagesTags(t *testing.T, mockMatcher *sdk.MockEC2API, tags map[string]map[string]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstancesPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) {
+			var instances []*ec2.Instance
+			for id, ts := range tags {
+				i := &ec2.Instance{
+					InstanceId: aws.String(id),
+					State:      &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+				}
+				for k, v := range ts {
+					i.Tags = append(i.Tags, &ec2.Tag{Key: aws.String(k), Value: aws.String(v)})
+				}
+				instances = append(instances, i)
+			}
+			resp := &ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{
+					&ec2.Reservation{Instances: instances},
+				},
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
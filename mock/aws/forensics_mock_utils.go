@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockGetConsoleOutput will return the console output of the requested instance from the received ones
+func MockGetConsoleOutput(t *testing.T, mockMatcher *sdk.MockEC2API, outputs map[string]string) {
+	logrus.Warningf("Mocking AWS iface: GetConsoleOutput")
+
+	// The response is filled with the requested instance output before returning it
+	var err error
+	resp := &ec2.GetConsoleOutputOutput{}
+	mockMatcher.EXPECT().GetConsoleOutput(gomock.Any()).Do(
+		func(input *ec2.GetConsoleOutputInput) {
+			resp.InstanceId = input.InstanceId
+			resp.Output = aws.String(base64.StdEncoding.EncodeToString([]byte(outputs[aws.StringValue(input.InstanceId)])))
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockGetConsoleOutputError will error on each call
+func MockGetConsoleOutputError(t *testing.T, mockMatcher *sdk.MockEC2API) {
+	logrus.Warningf("Mocking AWS iface: GetConsoleOutput")
+	err := errors.New("")
+	mockMatcher.EXPECT().GetConsoleOutput(gomock.Any()).AnyTimes().Return(nil, err)
+}
+
+// MockCreateSnapshot will append the snapshotted volumes on the received slice, the snapshot
+// IDs are the volume IDs with the snap- prefix
+func MockCreateSnapshot(t *testing.T, mockMatcher *sdk.MockEC2API, volumes *[]string) {
+	logrus.Warningf("Mocking AWS iface: CreateSnapshot")
+
+	// The response is filled with the snapshot of the requested volume before returning it
+	var err error
+	resp := &ec2.Snapshot{}
+	mockMatcher.EXPECT().CreateSnapshot(gomock.Any()).Do(
+		func(input *ec2.CreateSnapshotInput) {
+			*volumes = append(*volumes, aws.StringValue(input.VolumeId))
+			resp.VolumeId = input.VolumeId
+			resp.SnapshotId = aws.String(fmt.Sprintf("snap-%s", aws.StringValue(input.VolumeId)))
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockPutObject will set the body of the stored objects by bucket/key on the received map
+func MockPutObject(t *testing.T, mockMatcher *sdk.MockS3API, objects map[string]string) {
+	logrus.Warningf("Mocking AWS iface: PutObject")
+
+	var err error
+
+	mockMatcher.EXPECT().PutObject(gomock.Any()).Do(
+		func(input *s3.PutObjectInput) {
+			b, rerr := ioutil.ReadAll(input.Body)
+			if rerr != nil {
+				t.Fatalf("Error reading object body: %s", rerr)
+			}
+			objects[fmt.Sprintf("%s/%s", aws.StringValue(input.Bucket), aws.StringValue(input.Key))] = string(b)
+		}).AnyTimes().Return(nil, err)
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeAutoScalingGroupsPages will return the requested groups with their instances and
+// lifecycle states when calling
+func MockDescribeAutoScalingGroupsPages(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, groups map[string]map[string]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeAutoScalingGroupsPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeAutoScalingGroupsPages(gomock.Any(), gomock.Any()).Do(
+		func(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(p *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &autoscaling.DescribeAutoScalingGroupsOutput{}
+			for _, name := range input.AutoScalingGroupNames {
+				instances, ok := groups[aws.StringValue(name)]
+				if !ok {
+					continue
+				}
+				g := &autoscaling.Group{AutoScalingGroupName: name}
+				for id, state := range instances {
+					g.Instances = append(g.Instances, &autoscaling.Instance{
+						InstanceId:     aws.String(id),
+						LifecycleState: aws.String(state),
+					})
+				}
+				resp.AutoScalingGroups = append(resp.AutoScalingGroups, g)
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockDescribeInstancesPagesFiltered will return the received instances that match all the request
+// filters when calling, the instance-id, instance-state-name and tag:<key> filters are supported
+func MockDescribeInstancesPagesFiltered(t *testing.T, mockMatcher *sdk.MockEC2API, instances ...*ec2.Instance) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstancesPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) {
+			var matched []*ec2.Instance
+			for _, i := range instances {
+				if instanceMatches(t, i, input.Filters) {
+					matched = append(matched, i)
+				}
+			}
+			resp := &ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{
+					&ec2.Reservation{Instances: matched},
+				},
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// instanceMatches returns true if the instance matches all the filters, a value of each one
+func instanceMatches(t *testing.T, i *ec2.Instance, filters []*ec2.Filter) bool {
+	for _, f := range filters {
+		name := aws.StringValue(f.Name)
+		var value string
+		switch {
+		case name == "instance-id":
+			value = aws.StringValue(i.InstanceId)
+		case name == "instance-state-name":
+			if i.State != nil {
+				value = aws.StringValue(i.State.Name)
+			}
+		case strings.HasPrefix(name, "tag:"):
+			key := strings.TrimPrefix(name, "tag:")
+			for _, tag := range i.Tags {
+				if aws.StringValue(tag.Key) == key {
+					value = aws.StringValue(tag.Value)
+				}
+			}
+		default:
+			t.Fatalf("Unsupported DescribeInstances filter on the mock: %s", name)
+		}
+
+		found := false
+		for _, v := range f.Values {
+			if aws.StringValue(v) == value {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
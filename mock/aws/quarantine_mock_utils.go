@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeInstancesPagesByFilter will return the received instances by the name of the
+// first filter of the call
+func MockDescribeInstancesPagesByFilter(t *testing.T, mockMatcher *sdk.MockEC2API, instances map[string][]*ec2.Instance) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstancesPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) {
+			var filter string
+			if len(input.Filters) > 0 {
+				filter = aws.StringValue(input.Filters[0].Name)
+			}
+			resp := &ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{
+					&ec2.Reservation{Instances: instances[filter]},
+				},
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockDescribeAutoScalingInstancesPages will return the autoscaling group of the requested
+// instances from the received ones by instance
+func MockDescribeAutoScalingInstancesPages(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, groups map[string]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeAutoScalingInstancesPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeAutoScalingInstancesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *autoscaling.DescribeAutoScalingInstancesInput, fn func(p *autoscaling.DescribeAutoScalingInstancesOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &autoscaling.DescribeAutoScalingInstancesOutput{}
+			for _, id := range input.InstanceIds {
+				if g, ok := groups[aws.StringValue(id)]; ok {
+					resp.AutoScalingInstances = append(resp.AutoScalingInstances, &autoscaling.InstanceDetails{
+						InstanceId:           id,
+						AutoScalingGroupName: aws.String(g),
+					})
+				}
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockDetachInstances will append the detached instances by autoscaling group on the received map,
+// it fails the test if the desired capacity is decremented
+func MockDetachInstances(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, detached map[string][]string) {
+	logrus.Warningf("Mocking AWS iface: DetachInstances")
+
+	var err error
+
+	mockMatcher.EXPECT().DetachInstances(gomock.Any()).Do(
+		func(input *autoscaling.DetachInstancesInput) {
+			if aws.BoolValue(input.ShouldDecrementDesiredCapacity) {
+				t.Errorf("Detaching instances shouldn't decrement the desired capacity")
+			}
+			g := aws.StringValue(input.AutoScalingGroupName)
+			for _, id := range input.InstanceIds {
+				detached[g] = append(detached[g], aws.StringValue(id))
+			}
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockModifyInstanceAttribute will set the security groups of the modified instances on the received map
+func MockModifyInstanceAttribute(t *testing.T, mockMatcher *sdk.MockEC2API, groups map[string][]string) {
+	logrus.Warningf("Mocking AWS iface: ModifyInstanceAttribute")
+
+	var err error
+
+	mockMatcher.EXPECT().ModifyInstanceAttribute(gomock.Any()).Do(
+		func(input *ec2.ModifyInstanceAttributeInput) {
+			groups[aws.StringValue(input.InstanceId)] = aws.StringValueSlice(input.Groups)
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockStopInstances will append the stopped instances on the received slice
+func MockStopInstances(t *testing.T, mockMatcher *sdk.MockEC2API, stopped *[]string) {
+	logrus.Warningf("Mocking AWS iface: StopInstances")
+
+	var err error
+
+	mockMatcher.EXPECT().StopInstances(gomock.Any()).Do(
+		func(input *ec2.StopInstancesInput) {
+			for _, i := range input.InstanceIds {
+				*stopped = append(*stopped, aws.StringValue(i))
+			}
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockDescribeAutoScalingGroupsPagesSequence will return the received groups lists in order on
+// each call, the last one is returned on the next calls, the calls are counted on the received int
+func MockDescribeAutoScalingGroupsPagesSequence(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, calls *int, sequence ...[]*autoscaling.Group) {
+	logrus.Warningf("Mocking AWS iface: DescribeAutoScalingGroupsPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeAutoScalingGroupsPages(gomock.Any(), gomock.Any()).Do(
+		func(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(p *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) {
+			i := *calls
+			if i >= len(sequence) {
+				i = len(sequence) - 1
+			}
+			*calls++
+			fn(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: sequence[i]}, true)
+		}).AnyTimes().Return(err)
+}
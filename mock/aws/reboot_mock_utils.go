@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockRebootInstancesError will return error
+func MockRebootInstancesError(t *testing.T, mockMatcher *sdk.MockEC2API) {
+	logrus.Warningf("Mocking AWS iface: RebootInstances")
+
+	err := errors.New("")
+
+	mockMatcher.EXPECT().RebootInstances(gomock.Any()).AnyTimes().Return(nil, err)
+}
+
+// MockRebootInstances will append the rebooted instances on the received slice
+func MockRebootInstances(t *testing.T, mockMatcher *sdk.MockEC2API, rebooted *[]string) {
+	logrus.Warningf("Mocking AWS iface: RebootInstances")
+
+	var err error
+
+	mockMatcher.EXPECT().RebootInstances(gomock.Any()).Do(
+		func(input *ec2.RebootInstancesInput) {
+			for _, i := range input.InstanceIds {
+				*rebooted = append(*rebooted, aws.StringValue(i))
+			}
+		}).AnyTimes().Return(nil, err)
+}
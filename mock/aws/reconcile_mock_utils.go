@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeAutoScalingGroupsPagesGroups will return the received groups when calling
+func MockDescribeAutoScalingGroupsPagesGroups(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, groups ...*autoscaling.Group) {
+	logrus.Warningf("Mocking AWS iface: DescribeAutoScalingGroupsPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeAutoScalingGroupsPages(gomock.Any(), gomock.Any()).Do(
+		func(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(p *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) {
+			fn(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: groups}, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockPutMetricData will set the published metrics on the received map by name
+func MockPutMetricData(t *testing.T, mockMatcher *sdk.MockCloudWatchAPI, metrics map[string]float64) {
+	logrus.Warningf("Mocking AWS iface: PutMetricData")
+	var err error
+
+	mockMatcher.EXPECT().PutMetricData(gomock.Any()).Do(
+		func(input *cloudwatch.PutMetricDataInput) {
+			for _, d := range input.MetricData {
+				metrics[aws.StringValue(d.MetricName)] = aws.Float64Value(d.Value)
+			}
+		}).AnyTimes().Return(&cloudwatch.PutMetricDataOutput{}, err)
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeInstanceStatusPagesEvents will return the received events of the instances when calling
+func MockDescribeInstanceStatusPagesEvents(t *testing.T, mockMatcher *sdk.MockEC2API, events map[string][]*ec2.InstanceStatusEvent) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstanceStatusPages")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeInstanceStatusPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstanceStatusInput, fn func(p *ec2.DescribeInstanceStatusOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &ec2.DescribeInstanceStatusOutput{}
+			for id, evs := range events {
+				resp.InstanceStatuses = append(resp.InstanceStatuses, &ec2.InstanceStatus{
+					InstanceId: aws.String(id),
+					Events:     evs,
+				})
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockListTasksPages will return the tasks of the container instance when calling
+func MockListTasksPages(t *testing.T, mockMatcher *sdk.MockECSAPI, tasks map[string][]*ecs.Task) {
+	logrus.Warningf("Mocking AWS iface: ListTasksPages")
+
+	var err error
+
+	mockMatcher.EXPECT().ListTasksPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ecs.ListTasksInput, fn func(p *ecs.ListTasksOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &ecs.ListTasksOutput{}
+			for _, t := range tasks[aws.StringValue(input.ContainerInstance)] {
+				resp.TaskArns = append(resp.TaskArns, t.TaskArn)
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+}
+
+// MockDescribeTasks will return the requested tasks from the received ones
+func MockDescribeTasks(t *testing.T, mockMatcher *sdk.MockECSAPI, tasks map[string][]*ecs.Task) {
+	MockDescribeTasksRequested(t, mockMatcher, tasks, &[]string{})
+}
+
+// MockDescribeTasksRequested will return the requested tasks from the received ones and append
+// the requested ARNs on the received slice
+func MockDescribeTasksRequested(t *testing.T, mockMatcher *sdk.MockECSAPI, tasks map[string][]*ecs.Task, requested *[]string) {
+	logrus.Warningf("Mocking AWS iface: DescribeTasks")
+
+	// The response is filled with the requested tasks before returning it
+	var err error
+	resp := &ecs.DescribeTasksOutput{}
+	mockMatcher.EXPECT().DescribeTasks(gomock.Any()).Do(
+		func(input *ecs.DescribeTasksInput) {
+			byArn := map[string]*ecs.Task{}
+			for _, ts := range tasks {
+				for _, t := range ts {
+					byArn[aws.StringValue(t.TaskArn)] = t
+				}
+			}
+
+			resp.Tasks = nil
+			for _, arn := range input.Tasks {
+				*requested = append(*requested, aws.StringValue(arn))
+				if t, ok := byArn[aws.StringValue(arn)]; ok {
+					resp.Tasks = append(resp.Tasks, t)
+				}
+			}
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockUpdateContainerInstancesState will set the received status of the container instance ARNs
+// on the received map
+func MockUpdateContainerInstancesState(t *testing.T, mockMatcher *sdk.MockECSAPI, updated map[string]string) {
+	logrus.Warningf("Mocking AWS iface: UpdateContainerInstancesState")
+	var err error
+
+	mockMatcher.EXPECT().UpdateContainerInstancesState(gomock.Any()).Do(
+		func(input *ecs.UpdateContainerInstancesStateInput) {
+			for _, arn := range input.ContainerInstances {
+				updated[aws.StringValue(arn)] = aws.StringValue(input.Status)
+			}
+		}).AnyTimes().Return(&ecs.UpdateContainerInstancesStateOutput{}, err)
+}
@@ -0,0 +1,1851 @@
+// Automatically generated by MockGen. DO NOT EDIT!
+// Source: ./vendor/github.com/aws/aws-sdk-go/service/ssm/ssmiface/interface.go
+
+package sdk
+
+import (
+	request "github.com/aws/aws-sdk-go/aws/request"
+	ssm "github.com/aws/aws-sdk-go/service/ssm"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mock of SSMAPI interface
+type MockSSMAPI struct {
+	ctrl     *gomock.Controller
+	recorder *_MockSSMAPIRecorder
+}
+
+// Recorder for MockSSMAPI (not exported)
+type _MockSSMAPIRecorder struct {
+	mock *MockSSMAPI
+}
+
+func NewMockSSMAPI(ctrl *gomock.Controller) *MockSSMAPI {
+	mock := &MockSSMAPI{ctrl: ctrl}
+	mock.recorder = &_MockSSMAPIRecorder{mock}
+	return mock
+}
+
+func (_m *MockSSMAPI) EXPECT() *_MockSSMAPIRecorder {
+	return _m.recorder
+}
+
+func (_m *MockSSMAPI) AddTagsToResourceRequest(_param0 *ssm.AddTagsToResourceInput) (*request.Request, *ssm.AddTagsToResourceOutput) {
+	ret := _m.ctrl.Call(_m, "AddTagsToResourceRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.AddTagsToResourceOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) AddTagsToResourceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddTagsToResourceRequest", arg0)
+}
+
+func (_m *MockSSMAPI) AddTagsToResource(_param0 *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+	ret := _m.ctrl.Call(_m, "AddTagsToResource", _param0)
+	ret0, _ := ret[0].(*ssm.AddTagsToResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) AddTagsToResource(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddTagsToResource", arg0)
+}
+
+func (_m *MockSSMAPI) CancelCommandRequest(_param0 *ssm.CancelCommandInput) (*request.Request, *ssm.CancelCommandOutput) {
+	ret := _m.ctrl.Call(_m, "CancelCommandRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CancelCommandOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CancelCommandRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelCommandRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CancelCommand(_param0 *ssm.CancelCommandInput) (*ssm.CancelCommandOutput, error) {
+	ret := _m.ctrl.Call(_m, "CancelCommand", _param0)
+	ret0, _ := ret[0].(*ssm.CancelCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CancelCommand(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelCommand", arg0)
+}
+
+func (_m *MockSSMAPI) CreateActivationRequest(_param0 *ssm.CreateActivationInput) (*request.Request, *ssm.CreateActivationOutput) {
+	ret := _m.ctrl.Call(_m, "CreateActivationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateActivationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateActivationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateActivationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CreateActivation(_param0 *ssm.CreateActivationInput) (*ssm.CreateActivationOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateActivation", _param0)
+	ret0, _ := ret[0].(*ssm.CreateActivationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateActivation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateActivation", arg0)
+}
+
+func (_m *MockSSMAPI) CreateAssociationRequest(_param0 *ssm.CreateAssociationInput) (*request.Request, *ssm.CreateAssociationOutput) {
+	ret := _m.ctrl.Call(_m, "CreateAssociationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateAssociationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateAssociationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAssociationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CreateAssociation(_param0 *ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateAssociation", _param0)
+	ret0, _ := ret[0].(*ssm.CreateAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateAssociation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAssociation", arg0)
+}
+
+func (_m *MockSSMAPI) CreateAssociationBatchRequest(_param0 *ssm.CreateAssociationBatchInput) (*request.Request, *ssm.CreateAssociationBatchOutput) {
+	ret := _m.ctrl.Call(_m, "CreateAssociationBatchRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateAssociationBatchOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateAssociationBatchRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAssociationBatchRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CreateAssociationBatch(_param0 *ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateAssociationBatch", _param0)
+	ret0, _ := ret[0].(*ssm.CreateAssociationBatchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateAssociationBatch(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAssociationBatch", arg0)
+}
+
+func (_m *MockSSMAPI) CreateDocumentRequest(_param0 *ssm.CreateDocumentInput) (*request.Request, *ssm.CreateDocumentOutput) {
+	ret := _m.ctrl.Call(_m, "CreateDocumentRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateDocumentOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateDocumentRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateDocumentRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CreateDocument(_param0 *ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateDocument", _param0)
+	ret0, _ := ret[0].(*ssm.CreateDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateDocument(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateDocument", arg0)
+}
+
+func (_m *MockSSMAPI) CreateMaintenanceWindowRequest(_param0 *ssm.CreateMaintenanceWindowInput) (*request.Request, *ssm.CreateMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "CreateMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CreateMaintenanceWindow(_param0 *ssm.CreateMaintenanceWindowInput) (*ssm.CreateMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.CreateMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreateMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) CreatePatchBaselineRequest(_param0 *ssm.CreatePatchBaselineInput) (*request.Request, *ssm.CreatePatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "CreatePatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreatePatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreatePatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreatePatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) CreatePatchBaseline(_param0 *ssm.CreatePatchBaselineInput) (*ssm.CreatePatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreatePatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.CreatePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) CreatePatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreatePatchBaseline", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteActivationRequest(_param0 *ssm.DeleteActivationInput) (*request.Request, *ssm.DeleteActivationOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteActivationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteActivationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteActivationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteActivationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteActivation(_param0 *ssm.DeleteActivationInput) (*ssm.DeleteActivationOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteActivation", _param0)
+	ret0, _ := ret[0].(*ssm.DeleteActivationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteActivation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteActivation", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteAssociationRequest(_param0 *ssm.DeleteAssociationInput) (*request.Request, *ssm.DeleteAssociationOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteAssociationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteAssociationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteAssociationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAssociationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteAssociation(_param0 *ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteAssociation", _param0)
+	ret0, _ := ret[0].(*ssm.DeleteAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteAssociation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAssociation", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteDocumentRequest(_param0 *ssm.DeleteDocumentInput) (*request.Request, *ssm.DeleteDocumentOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteDocumentRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteDocumentOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteDocumentRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteDocumentRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteDocument(_param0 *ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteDocument", _param0)
+	ret0, _ := ret[0].(*ssm.DeleteDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteDocument(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteDocument", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteMaintenanceWindowRequest(_param0 *ssm.DeleteMaintenanceWindowInput) (*request.Request, *ssm.DeleteMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteMaintenanceWindow(_param0 *ssm.DeleteMaintenanceWindowInput) (*ssm.DeleteMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.DeleteMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteParameterRequest(_param0 *ssm.DeleteParameterInput) (*request.Request, *ssm.DeleteParameterOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteParameterRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteParameterOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteParameterRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteParameterRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeleteParameter(_param0 *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteParameter", _param0)
+	ret0, _ := ret[0].(*ssm.DeleteParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeleteParameter(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteParameter", arg0)
+}
+
+func (_m *MockSSMAPI) DeletePatchBaselineRequest(_param0 *ssm.DeletePatchBaselineInput) (*request.Request, *ssm.DeletePatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "DeletePatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeletePatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeletePatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeletePatchBaseline(_param0 *ssm.DeletePatchBaselineInput) (*ssm.DeletePatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeletePatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.DeletePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeletePatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePatchBaseline", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterManagedInstanceRequest(_param0 *ssm.DeregisterManagedInstanceInput) (*request.Request, *ssm.DeregisterManagedInstanceOutput) {
+	ret := _m.ctrl.Call(_m, "DeregisterManagedInstanceRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterManagedInstanceOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterManagedInstanceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterManagedInstanceRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterManagedInstance(_param0 *ssm.DeregisterManagedInstanceInput) (*ssm.DeregisterManagedInstanceOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeregisterManagedInstance", _param0)
+	ret0, _ := ret[0].(*ssm.DeregisterManagedInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterManagedInstance(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterManagedInstance", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterPatchBaselineForPatchGroupRequest(_param0 *ssm.DeregisterPatchBaselineForPatchGroupInput) (*request.Request, *ssm.DeregisterPatchBaselineForPatchGroupOutput) {
+	ret := _m.ctrl.Call(_m, "DeregisterPatchBaselineForPatchGroupRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterPatchBaselineForPatchGroupOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterPatchBaselineForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterPatchBaselineForPatchGroupRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterPatchBaselineForPatchGroup(_param0 *ssm.DeregisterPatchBaselineForPatchGroupInput) (*ssm.DeregisterPatchBaselineForPatchGroupOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeregisterPatchBaselineForPatchGroup", _param0)
+	ret0, _ := ret[0].(*ssm.DeregisterPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterPatchBaselineForPatchGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterPatchBaselineForPatchGroup", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterTargetFromMaintenanceWindowRequest(_param0 *ssm.DeregisterTargetFromMaintenanceWindowInput) (*request.Request, *ssm.DeregisterTargetFromMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "DeregisterTargetFromMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterTargetFromMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterTargetFromMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterTargetFromMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterTargetFromMaintenanceWindow(_param0 *ssm.DeregisterTargetFromMaintenanceWindowInput) (*ssm.DeregisterTargetFromMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeregisterTargetFromMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.DeregisterTargetFromMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterTargetFromMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterTargetFromMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterTaskFromMaintenanceWindowRequest(_param0 *ssm.DeregisterTaskFromMaintenanceWindowInput) (*request.Request, *ssm.DeregisterTaskFromMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "DeregisterTaskFromMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterTaskFromMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterTaskFromMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterTaskFromMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DeregisterTaskFromMaintenanceWindow(_param0 *ssm.DeregisterTaskFromMaintenanceWindowInput) (*ssm.DeregisterTaskFromMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeregisterTaskFromMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.DeregisterTaskFromMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DeregisterTaskFromMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterTaskFromMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeActivationsRequest(_param0 *ssm.DescribeActivationsInput) (*request.Request, *ssm.DescribeActivationsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeActivationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeActivationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeActivationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeActivationsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeActivations(_param0 *ssm.DescribeActivationsInput) (*ssm.DescribeActivationsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeActivations", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeActivationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeActivations(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeActivations", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeActivationsPages(_param0 *ssm.DescribeActivationsInput, _param1 func(*ssm.DescribeActivationsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "DescribeActivationsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeActivationsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeActivationsPages", arg0, arg1)
+}
+
+func (_m *MockSSMAPI) DescribeAssociationRequest(_param0 *ssm.DescribeAssociationInput) (*request.Request, *ssm.DescribeAssociationOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeAssociationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAssociationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeAssociationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAssociationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeAssociation(_param0 *ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeAssociation", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeAssociation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAssociation", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeAutomationExecutionsRequest(_param0 *ssm.DescribeAutomationExecutionsInput) (*request.Request, *ssm.DescribeAutomationExecutionsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeAutomationExecutionsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAutomationExecutionsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeAutomationExecutionsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutomationExecutionsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeAutomationExecutions(_param0 *ssm.DescribeAutomationExecutionsInput) (*ssm.DescribeAutomationExecutionsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeAutomationExecutions", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeAutomationExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeAutomationExecutions(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutomationExecutions", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeAvailablePatchesRequest(_param0 *ssm.DescribeAvailablePatchesInput) (*request.Request, *ssm.DescribeAvailablePatchesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeAvailablePatchesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAvailablePatchesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeAvailablePatchesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAvailablePatchesRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeAvailablePatches(_param0 *ssm.DescribeAvailablePatchesInput) (*ssm.DescribeAvailablePatchesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeAvailablePatches", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeAvailablePatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeAvailablePatches(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAvailablePatches", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeDocumentRequest(_param0 *ssm.DescribeDocumentInput) (*request.Request, *ssm.DescribeDocumentOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeDocumentRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeDocumentOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeDocumentRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeDocumentRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeDocument(_param0 *ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeDocument", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeDocument(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeDocument", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeDocumentPermissionRequest(_param0 *ssm.DescribeDocumentPermissionInput) (*request.Request, *ssm.DescribeDocumentPermissionOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeDocumentPermissionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeDocumentPermissionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeDocumentPermissionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeDocumentPermissionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeDocumentPermission(_param0 *ssm.DescribeDocumentPermissionInput) (*ssm.DescribeDocumentPermissionOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeDocumentPermission", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeDocumentPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeDocumentPermission(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeDocumentPermission", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeEffectiveInstanceAssociationsRequest(_param0 *ssm.DescribeEffectiveInstanceAssociationsInput) (*request.Request, *ssm.DescribeEffectiveInstanceAssociationsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeEffectiveInstanceAssociationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeEffectiveInstanceAssociationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeEffectiveInstanceAssociationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeEffectiveInstanceAssociationsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeEffectiveInstanceAssociations(_param0 *ssm.DescribeEffectiveInstanceAssociationsInput) (*ssm.DescribeEffectiveInstanceAssociationsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeEffectiveInstanceAssociations", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeEffectiveInstanceAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeEffectiveInstanceAssociations(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeEffectiveInstanceAssociations", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeEffectivePatchesForPatchBaselineRequest(_param0 *ssm.DescribeEffectivePatchesForPatchBaselineInput) (*request.Request, *ssm.DescribeEffectivePatchesForPatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeEffectivePatchesForPatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeEffectivePatchesForPatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeEffectivePatchesForPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeEffectivePatchesForPatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeEffectivePatchesForPatchBaseline(_param0 *ssm.DescribeEffectivePatchesForPatchBaselineInput) (*ssm.DescribeEffectivePatchesForPatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeEffectivePatchesForPatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeEffectivePatchesForPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeEffectivePatchesForPatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeEffectivePatchesForPatchBaseline", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstanceAssociationsStatusRequest(_param0 *ssm.DescribeInstanceAssociationsStatusInput) (*request.Request, *ssm.DescribeInstanceAssociationsStatusOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeInstanceAssociationsStatusRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstanceAssociationsStatusOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstanceAssociationsStatusRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceAssociationsStatusRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstanceAssociationsStatus(_param0 *ssm.DescribeInstanceAssociationsStatusInput) (*ssm.DescribeInstanceAssociationsStatusOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeInstanceAssociationsStatus", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeInstanceAssociationsStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstanceAssociationsStatus(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceAssociationsStatus", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstanceInformationRequest(_param0 *ssm.DescribeInstanceInformationInput) (*request.Request, *ssm.DescribeInstanceInformationOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeInstanceInformationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstanceInformationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstanceInformationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceInformationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstanceInformation(_param0 *ssm.DescribeInstanceInformationInput) (*ssm.DescribeInstanceInformationOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeInstanceInformation", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeInstanceInformationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstanceInformation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceInformation", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstanceInformationPages(_param0 *ssm.DescribeInstanceInformationInput, _param1 func(*ssm.DescribeInstanceInformationOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "DescribeInstanceInformationPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstanceInformationPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceInformationPages", arg0, arg1)
+}
+
+func (_m *MockSSMAPI) DescribeInstancePatchStatesRequest(_param0 *ssm.DescribeInstancePatchStatesInput) (*request.Request, *ssm.DescribeInstancePatchStatesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeInstancePatchStatesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstancePatchStatesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstancePatchStatesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancePatchStatesRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstancePatchStates(_param0 *ssm.DescribeInstancePatchStatesInput) (*ssm.DescribeInstancePatchStatesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeInstancePatchStates", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchStatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstancePatchStates(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancePatchStates", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstancePatchStatesForPatchGroupRequest(_param0 *ssm.DescribeInstancePatchStatesForPatchGroupInput) (*request.Request, *ssm.DescribeInstancePatchStatesForPatchGroupOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeInstancePatchStatesForPatchGroupRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstancePatchStatesForPatchGroupOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstancePatchStatesForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancePatchStatesForPatchGroupRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstancePatchStatesForPatchGroup(_param0 *ssm.DescribeInstancePatchStatesForPatchGroupInput) (*ssm.DescribeInstancePatchStatesForPatchGroupOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeInstancePatchStatesForPatchGroup", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchStatesForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstancePatchStatesForPatchGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancePatchStatesForPatchGroup", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstancePatchesRequest(_param0 *ssm.DescribeInstancePatchesInput) (*request.Request, *ssm.DescribeInstancePatchesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeInstancePatchesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstancePatchesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstancePatchesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancePatchesRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeInstancePatches(_param0 *ssm.DescribeInstancePatchesInput) (*ssm.DescribeInstancePatchesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeInstancePatches", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeInstancePatches(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancePatches", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowExecutionTaskInvocationsRequest(_param0 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput) (*request.Request, *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowExecutionTaskInvocationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowExecutionTaskInvocationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocationsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowExecutionTaskInvocations(_param0 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput) (*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowExecutionTaskInvocations", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowExecutionTaskInvocations(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocations", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowExecutionTasksRequest(_param0 *ssm.DescribeMaintenanceWindowExecutionTasksInput) (*request.Request, *ssm.DescribeMaintenanceWindowExecutionTasksOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowExecutionTasksRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowExecutionTasksOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowExecutionTasksRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowExecutionTasksRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowExecutionTasks(_param0 *ssm.DescribeMaintenanceWindowExecutionTasksInput) (*ssm.DescribeMaintenanceWindowExecutionTasksOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowExecutionTasks", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowExecutionTasks(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowExecutionTasks", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowExecutionsRequest(_param0 *ssm.DescribeMaintenanceWindowExecutionsInput) (*request.Request, *ssm.DescribeMaintenanceWindowExecutionsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowExecutionsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowExecutionsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowExecutionsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowExecutionsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowExecutions(_param0 *ssm.DescribeMaintenanceWindowExecutionsInput) (*ssm.DescribeMaintenanceWindowExecutionsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowExecutions", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowExecutions(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowExecutions", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowTargetsRequest(_param0 *ssm.DescribeMaintenanceWindowTargetsInput) (*request.Request, *ssm.DescribeMaintenanceWindowTargetsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowTargetsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowTargetsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowTargetsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowTargetsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowTargets(_param0 *ssm.DescribeMaintenanceWindowTargetsInput) (*ssm.DescribeMaintenanceWindowTargetsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowTargets", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowTargets(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowTargets", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowTasksRequest(_param0 *ssm.DescribeMaintenanceWindowTasksInput) (*request.Request, *ssm.DescribeMaintenanceWindowTasksOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowTasksRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowTasksOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowTasksRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowTasksRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowTasks(_param0 *ssm.DescribeMaintenanceWindowTasksInput) (*ssm.DescribeMaintenanceWindowTasksOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowTasks", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowTasks(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowTasks", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindowsRequest(_param0 *ssm.DescribeMaintenanceWindowsInput) (*request.Request, *ssm.DescribeMaintenanceWindowsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindowsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindowsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindowsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeMaintenanceWindows(_param0 *ssm.DescribeMaintenanceWindowsInput) (*ssm.DescribeMaintenanceWindowsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeMaintenanceWindows", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeMaintenanceWindows(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMaintenanceWindows", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeParametersRequest(_param0 *ssm.DescribeParametersInput) (*request.Request, *ssm.DescribeParametersOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeParametersRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeParametersOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeParametersRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeParametersRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribeParameters(_param0 *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribeParameters", _param0)
+	ret0, _ := ret[0].(*ssm.DescribeParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribeParameters(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeParameters", arg0)
+}
+
+func (_m *MockSSMAPI) DescribePatchBaselinesRequest(_param0 *ssm.DescribePatchBaselinesInput) (*request.Request, *ssm.DescribePatchBaselinesOutput) {
+	ret := _m.ctrl.Call(_m, "DescribePatchBaselinesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchBaselinesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribePatchBaselinesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePatchBaselinesRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribePatchBaselines(_param0 *ssm.DescribePatchBaselinesInput) (*ssm.DescribePatchBaselinesOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribePatchBaselines", _param0)
+	ret0, _ := ret[0].(*ssm.DescribePatchBaselinesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribePatchBaselines(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePatchBaselines", arg0)
+}
+
+func (_m *MockSSMAPI) DescribePatchGroupStateRequest(_param0 *ssm.DescribePatchGroupStateInput) (*request.Request, *ssm.DescribePatchGroupStateOutput) {
+	ret := _m.ctrl.Call(_m, "DescribePatchGroupStateRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchGroupStateOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribePatchGroupStateRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePatchGroupStateRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribePatchGroupState(_param0 *ssm.DescribePatchGroupStateInput) (*ssm.DescribePatchGroupStateOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribePatchGroupState", _param0)
+	ret0, _ := ret[0].(*ssm.DescribePatchGroupStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribePatchGroupState(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePatchGroupState", arg0)
+}
+
+func (_m *MockSSMAPI) DescribePatchGroupsRequest(_param0 *ssm.DescribePatchGroupsInput) (*request.Request, *ssm.DescribePatchGroupsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribePatchGroupsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchGroupsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribePatchGroupsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePatchGroupsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) DescribePatchGroups(_param0 *ssm.DescribePatchGroupsInput) (*ssm.DescribePatchGroupsOutput, error) {
+	ret := _m.ctrl.Call(_m, "DescribePatchGroups", _param0)
+	ret0, _ := ret[0].(*ssm.DescribePatchGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) DescribePatchGroups(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePatchGroups", arg0)
+}
+
+func (_m *MockSSMAPI) GetAutomationExecutionRequest(_param0 *ssm.GetAutomationExecutionInput) (*request.Request, *ssm.GetAutomationExecutionOutput) {
+	ret := _m.ctrl.Call(_m, "GetAutomationExecutionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetAutomationExecutionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetAutomationExecutionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetAutomationExecutionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetAutomationExecution(_param0 *ssm.GetAutomationExecutionInput) (*ssm.GetAutomationExecutionOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetAutomationExecution", _param0)
+	ret0, _ := ret[0].(*ssm.GetAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetAutomationExecution(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetAutomationExecution", arg0)
+}
+
+func (_m *MockSSMAPI) GetCommandInvocationRequest(_param0 *ssm.GetCommandInvocationInput) (*request.Request, *ssm.GetCommandInvocationOutput) {
+	ret := _m.ctrl.Call(_m, "GetCommandInvocationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetCommandInvocationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetCommandInvocationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetCommandInvocationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetCommandInvocation(_param0 *ssm.GetCommandInvocationInput) (*ssm.GetCommandInvocationOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetCommandInvocation", _param0)
+	ret0, _ := ret[0].(*ssm.GetCommandInvocationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetCommandInvocation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetCommandInvocation", arg0)
+}
+
+func (_m *MockSSMAPI) GetDefaultPatchBaselineRequest(_param0 *ssm.GetDefaultPatchBaselineInput) (*request.Request, *ssm.GetDefaultPatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "GetDefaultPatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetDefaultPatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetDefaultPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDefaultPatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetDefaultPatchBaseline(_param0 *ssm.GetDefaultPatchBaselineInput) (*ssm.GetDefaultPatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetDefaultPatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.GetDefaultPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetDefaultPatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDefaultPatchBaseline", arg0)
+}
+
+func (_m *MockSSMAPI) GetDeployablePatchSnapshotForInstanceRequest(_param0 *ssm.GetDeployablePatchSnapshotForInstanceInput) (*request.Request, *ssm.GetDeployablePatchSnapshotForInstanceOutput) {
+	ret := _m.ctrl.Call(_m, "GetDeployablePatchSnapshotForInstanceRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetDeployablePatchSnapshotForInstanceOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetDeployablePatchSnapshotForInstanceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDeployablePatchSnapshotForInstanceRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetDeployablePatchSnapshotForInstance(_param0 *ssm.GetDeployablePatchSnapshotForInstanceInput) (*ssm.GetDeployablePatchSnapshotForInstanceOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetDeployablePatchSnapshotForInstance", _param0)
+	ret0, _ := ret[0].(*ssm.GetDeployablePatchSnapshotForInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetDeployablePatchSnapshotForInstance(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDeployablePatchSnapshotForInstance", arg0)
+}
+
+func (_m *MockSSMAPI) GetDocumentRequest(_param0 *ssm.GetDocumentInput) (*request.Request, *ssm.GetDocumentOutput) {
+	ret := _m.ctrl.Call(_m, "GetDocumentRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetDocumentOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetDocumentRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDocumentRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetDocument(_param0 *ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetDocument", _param0)
+	ret0, _ := ret[0].(*ssm.GetDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetDocument(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetDocument", arg0)
+}
+
+func (_m *MockSSMAPI) GetInventoryRequest(_param0 *ssm.GetInventoryInput) (*request.Request, *ssm.GetInventoryOutput) {
+	ret := _m.ctrl.Call(_m, "GetInventoryRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetInventoryOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetInventoryRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetInventoryRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetInventory(_param0 *ssm.GetInventoryInput) (*ssm.GetInventoryOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetInventory", _param0)
+	ret0, _ := ret[0].(*ssm.GetInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetInventory(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetInventory", arg0)
+}
+
+func (_m *MockSSMAPI) GetInventorySchemaRequest(_param0 *ssm.GetInventorySchemaInput) (*request.Request, *ssm.GetInventorySchemaOutput) {
+	ret := _m.ctrl.Call(_m, "GetInventorySchemaRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetInventorySchemaOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetInventorySchemaRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetInventorySchemaRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetInventorySchema(_param0 *ssm.GetInventorySchemaInput) (*ssm.GetInventorySchemaOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetInventorySchema", _param0)
+	ret0, _ := ret[0].(*ssm.GetInventorySchemaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetInventorySchema(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetInventorySchema", arg0)
+}
+
+func (_m *MockSSMAPI) GetMaintenanceWindowRequest(_param0 *ssm.GetMaintenanceWindowInput) (*request.Request, *ssm.GetMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "GetMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetMaintenanceWindow(_param0 *ssm.GetMaintenanceWindowInput) (*ssm.GetMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) GetMaintenanceWindowExecutionRequest(_param0 *ssm.GetMaintenanceWindowExecutionInput) (*request.Request, *ssm.GetMaintenanceWindowExecutionOutput) {
+	ret := _m.ctrl.Call(_m, "GetMaintenanceWindowExecutionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowExecutionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetMaintenanceWindowExecutionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMaintenanceWindowExecutionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetMaintenanceWindowExecution(_param0 *ssm.GetMaintenanceWindowExecutionInput) (*ssm.GetMaintenanceWindowExecutionOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetMaintenanceWindowExecution", _param0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetMaintenanceWindowExecution(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMaintenanceWindowExecution", arg0)
+}
+
+func (_m *MockSSMAPI) GetMaintenanceWindowExecutionTaskRequest(_param0 *ssm.GetMaintenanceWindowExecutionTaskInput) (*request.Request, *ssm.GetMaintenanceWindowExecutionTaskOutput) {
+	ret := _m.ctrl.Call(_m, "GetMaintenanceWindowExecutionTaskRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowExecutionTaskOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetMaintenanceWindowExecutionTaskRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMaintenanceWindowExecutionTaskRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetMaintenanceWindowExecutionTask(_param0 *ssm.GetMaintenanceWindowExecutionTaskInput) (*ssm.GetMaintenanceWindowExecutionTaskOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetMaintenanceWindowExecutionTask", _param0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetMaintenanceWindowExecutionTask(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMaintenanceWindowExecutionTask", arg0)
+}
+
+func (_m *MockSSMAPI) GetParameterHistoryRequest(_param0 *ssm.GetParameterHistoryInput) (*request.Request, *ssm.GetParameterHistoryOutput) {
+	ret := _m.ctrl.Call(_m, "GetParameterHistoryRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetParameterHistoryOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetParameterHistoryRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetParameterHistoryRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetParameterHistory(_param0 *ssm.GetParameterHistoryInput) (*ssm.GetParameterHistoryOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetParameterHistory", _param0)
+	ret0, _ := ret[0].(*ssm.GetParameterHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetParameterHistory(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetParameterHistory", arg0)
+}
+
+func (_m *MockSSMAPI) GetParametersRequest(_param0 *ssm.GetParametersInput) (*request.Request, *ssm.GetParametersOutput) {
+	ret := _m.ctrl.Call(_m, "GetParametersRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetParametersOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetParametersRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetParametersRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetParameters(_param0 *ssm.GetParametersInput) (*ssm.GetParametersOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetParameters", _param0)
+	ret0, _ := ret[0].(*ssm.GetParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetParameters(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetParameters", arg0)
+}
+
+func (_m *MockSSMAPI) GetPatchBaselineRequest(_param0 *ssm.GetPatchBaselineInput) (*request.Request, *ssm.GetPatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "GetPatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetPatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetPatchBaseline(_param0 *ssm.GetPatchBaselineInput) (*ssm.GetPatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetPatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.GetPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetPatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPatchBaseline", arg0)
+}
+
+func (_m *MockSSMAPI) GetPatchBaselineForPatchGroupRequest(_param0 *ssm.GetPatchBaselineForPatchGroupInput) (*request.Request, *ssm.GetPatchBaselineForPatchGroupOutput) {
+	ret := _m.ctrl.Call(_m, "GetPatchBaselineForPatchGroupRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetPatchBaselineForPatchGroupOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetPatchBaselineForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPatchBaselineForPatchGroupRequest", arg0)
+}
+
+func (_m *MockSSMAPI) GetPatchBaselineForPatchGroup(_param0 *ssm.GetPatchBaselineForPatchGroupInput) (*ssm.GetPatchBaselineForPatchGroupOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetPatchBaselineForPatchGroup", _param0)
+	ret0, _ := ret[0].(*ssm.GetPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) GetPatchBaselineForPatchGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPatchBaselineForPatchGroup", arg0)
+}
+
+func (_m *MockSSMAPI) ListAssociationsRequest(_param0 *ssm.ListAssociationsInput) (*request.Request, *ssm.ListAssociationsOutput) {
+	ret := _m.ctrl.Call(_m, "ListAssociationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListAssociationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListAssociationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListAssociationsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListAssociations(_param0 *ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListAssociations", _param0)
+	ret0, _ := ret[0].(*ssm.ListAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListAssociations(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListAssociations", arg0)
+}
+
+func (_m *MockSSMAPI) ListAssociationsPages(_param0 *ssm.ListAssociationsInput, _param1 func(*ssm.ListAssociationsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListAssociationsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockSSMAPIRecorder) ListAssociationsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListAssociationsPages", arg0, arg1)
+}
+
+func (_m *MockSSMAPI) ListCommandInvocationsRequest(_param0 *ssm.ListCommandInvocationsInput) (*request.Request, *ssm.ListCommandInvocationsOutput) {
+	ret := _m.ctrl.Call(_m, "ListCommandInvocationsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListCommandInvocationsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListCommandInvocationsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListCommandInvocationsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListCommandInvocations(_param0 *ssm.ListCommandInvocationsInput) (*ssm.ListCommandInvocationsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListCommandInvocations", _param0)
+	ret0, _ := ret[0].(*ssm.ListCommandInvocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListCommandInvocations(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListCommandInvocations", arg0)
+}
+
+func (_m *MockSSMAPI) ListCommandInvocationsPages(_param0 *ssm.ListCommandInvocationsInput, _param1 func(*ssm.ListCommandInvocationsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListCommandInvocationsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockSSMAPIRecorder) ListCommandInvocationsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListCommandInvocationsPages", arg0, arg1)
+}
+
+func (_m *MockSSMAPI) ListCommandsRequest(_param0 *ssm.ListCommandsInput) (*request.Request, *ssm.ListCommandsOutput) {
+	ret := _m.ctrl.Call(_m, "ListCommandsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListCommandsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListCommandsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListCommandsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListCommands(_param0 *ssm.ListCommandsInput) (*ssm.ListCommandsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListCommands", _param0)
+	ret0, _ := ret[0].(*ssm.ListCommandsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListCommands(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListCommands", arg0)
+}
+
+func (_m *MockSSMAPI) ListCommandsPages(_param0 *ssm.ListCommandsInput, _param1 func(*ssm.ListCommandsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListCommandsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockSSMAPIRecorder) ListCommandsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListCommandsPages", arg0, arg1)
+}
+
+func (_m *MockSSMAPI) ListDocumentVersionsRequest(_param0 *ssm.ListDocumentVersionsInput) (*request.Request, *ssm.ListDocumentVersionsOutput) {
+	ret := _m.ctrl.Call(_m, "ListDocumentVersionsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListDocumentVersionsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListDocumentVersionsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListDocumentVersionsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListDocumentVersions(_param0 *ssm.ListDocumentVersionsInput) (*ssm.ListDocumentVersionsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListDocumentVersions", _param0)
+	ret0, _ := ret[0].(*ssm.ListDocumentVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListDocumentVersions(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListDocumentVersions", arg0)
+}
+
+func (_m *MockSSMAPI) ListDocumentsRequest(_param0 *ssm.ListDocumentsInput) (*request.Request, *ssm.ListDocumentsOutput) {
+	ret := _m.ctrl.Call(_m, "ListDocumentsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListDocumentsOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListDocumentsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListDocumentsRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListDocuments(_param0 *ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListDocuments", _param0)
+	ret0, _ := ret[0].(*ssm.ListDocumentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListDocuments(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListDocuments", arg0)
+}
+
+func (_m *MockSSMAPI) ListDocumentsPages(_param0 *ssm.ListDocumentsInput, _param1 func(*ssm.ListDocumentsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListDocumentsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockSSMAPIRecorder) ListDocumentsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListDocumentsPages", arg0, arg1)
+}
+
+func (_m *MockSSMAPI) ListInventoryEntriesRequest(_param0 *ssm.ListInventoryEntriesInput) (*request.Request, *ssm.ListInventoryEntriesOutput) {
+	ret := _m.ctrl.Call(_m, "ListInventoryEntriesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListInventoryEntriesOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListInventoryEntriesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListInventoryEntriesRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListInventoryEntries(_param0 *ssm.ListInventoryEntriesInput) (*ssm.ListInventoryEntriesOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListInventoryEntries", _param0)
+	ret0, _ := ret[0].(*ssm.ListInventoryEntriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListInventoryEntries(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListInventoryEntries", arg0)
+}
+
+func (_m *MockSSMAPI) ListTagsForResourceRequest(_param0 *ssm.ListTagsForResourceInput) (*request.Request, *ssm.ListTagsForResourceOutput) {
+	ret := _m.ctrl.Call(_m, "ListTagsForResourceRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListTagsForResourceOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListTagsForResourceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListTagsForResourceRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ListTagsForResource(_param0 *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListTagsForResource", _param0)
+	ret0, _ := ret[0].(*ssm.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ListTagsForResource(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListTagsForResource", arg0)
+}
+
+func (_m *MockSSMAPI) ModifyDocumentPermissionRequest(_param0 *ssm.ModifyDocumentPermissionInput) (*request.Request, *ssm.ModifyDocumentPermissionOutput) {
+	ret := _m.ctrl.Call(_m, "ModifyDocumentPermissionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ModifyDocumentPermissionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ModifyDocumentPermissionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyDocumentPermissionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) ModifyDocumentPermission(_param0 *ssm.ModifyDocumentPermissionInput) (*ssm.ModifyDocumentPermissionOutput, error) {
+	ret := _m.ctrl.Call(_m, "ModifyDocumentPermission", _param0)
+	ret0, _ := ret[0].(*ssm.ModifyDocumentPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) ModifyDocumentPermission(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyDocumentPermission", arg0)
+}
+
+func (_m *MockSSMAPI) PutInventoryRequest(_param0 *ssm.PutInventoryInput) (*request.Request, *ssm.PutInventoryOutput) {
+	ret := _m.ctrl.Call(_m, "PutInventoryRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.PutInventoryOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) PutInventoryRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutInventoryRequest", arg0)
+}
+
+func (_m *MockSSMAPI) PutInventory(_param0 *ssm.PutInventoryInput) (*ssm.PutInventoryOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutInventory", _param0)
+	ret0, _ := ret[0].(*ssm.PutInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) PutInventory(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutInventory", arg0)
+}
+
+func (_m *MockSSMAPI) PutParameterRequest(_param0 *ssm.PutParameterInput) (*request.Request, *ssm.PutParameterOutput) {
+	ret := _m.ctrl.Call(_m, "PutParameterRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.PutParameterOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) PutParameterRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutParameterRequest", arg0)
+}
+
+func (_m *MockSSMAPI) PutParameter(_param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutParameter", _param0)
+	ret0, _ := ret[0].(*ssm.PutParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) PutParameter(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutParameter", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterDefaultPatchBaselineRequest(_param0 *ssm.RegisterDefaultPatchBaselineInput) (*request.Request, *ssm.RegisterDefaultPatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "RegisterDefaultPatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterDefaultPatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterDefaultPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterDefaultPatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterDefaultPatchBaseline(_param0 *ssm.RegisterDefaultPatchBaselineInput) (*ssm.RegisterDefaultPatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "RegisterDefaultPatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.RegisterDefaultPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterDefaultPatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterDefaultPatchBaseline", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterPatchBaselineForPatchGroupRequest(_param0 *ssm.RegisterPatchBaselineForPatchGroupInput) (*request.Request, *ssm.RegisterPatchBaselineForPatchGroupOutput) {
+	ret := _m.ctrl.Call(_m, "RegisterPatchBaselineForPatchGroupRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterPatchBaselineForPatchGroupOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterPatchBaselineForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterPatchBaselineForPatchGroupRequest", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterPatchBaselineForPatchGroup(_param0 *ssm.RegisterPatchBaselineForPatchGroupInput) (*ssm.RegisterPatchBaselineForPatchGroupOutput, error) {
+	ret := _m.ctrl.Call(_m, "RegisterPatchBaselineForPatchGroup", _param0)
+	ret0, _ := ret[0].(*ssm.RegisterPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterPatchBaselineForPatchGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterPatchBaselineForPatchGroup", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterTargetWithMaintenanceWindowRequest(_param0 *ssm.RegisterTargetWithMaintenanceWindowInput) (*request.Request, *ssm.RegisterTargetWithMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "RegisterTargetWithMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterTargetWithMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterTargetWithMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterTargetWithMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterTargetWithMaintenanceWindow(_param0 *ssm.RegisterTargetWithMaintenanceWindowInput) (*ssm.RegisterTargetWithMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "RegisterTargetWithMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.RegisterTargetWithMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterTargetWithMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterTargetWithMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterTaskWithMaintenanceWindowRequest(_param0 *ssm.RegisterTaskWithMaintenanceWindowInput) (*request.Request, *ssm.RegisterTaskWithMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "RegisterTaskWithMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterTaskWithMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterTaskWithMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterTaskWithMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) RegisterTaskWithMaintenanceWindow(_param0 *ssm.RegisterTaskWithMaintenanceWindowInput) (*ssm.RegisterTaskWithMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "RegisterTaskWithMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.RegisterTaskWithMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RegisterTaskWithMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterTaskWithMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) RemoveTagsFromResourceRequest(_param0 *ssm.RemoveTagsFromResourceInput) (*request.Request, *ssm.RemoveTagsFromResourceOutput) {
+	ret := _m.ctrl.Call(_m, "RemoveTagsFromResourceRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RemoveTagsFromResourceOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RemoveTagsFromResourceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveTagsFromResourceRequest", arg0)
+}
+
+func (_m *MockSSMAPI) RemoveTagsFromResource(_param0 *ssm.RemoveTagsFromResourceInput) (*ssm.RemoveTagsFromResourceOutput, error) {
+	ret := _m.ctrl.Call(_m, "RemoveTagsFromResource", _param0)
+	ret0, _ := ret[0].(*ssm.RemoveTagsFromResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) RemoveTagsFromResource(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveTagsFromResource", arg0)
+}
+
+func (_m *MockSSMAPI) SendCommandRequest(_param0 *ssm.SendCommandInput) (*request.Request, *ssm.SendCommandOutput) {
+	ret := _m.ctrl.Call(_m, "SendCommandRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.SendCommandOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) SendCommandRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SendCommandRequest", arg0)
+}
+
+func (_m *MockSSMAPI) SendCommand(_param0 *ssm.SendCommandInput) (*ssm.SendCommandOutput, error) {
+	ret := _m.ctrl.Call(_m, "SendCommand", _param0)
+	ret0, _ := ret[0].(*ssm.SendCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) SendCommand(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SendCommand", arg0)
+}
+
+func (_m *MockSSMAPI) StartAutomationExecutionRequest(_param0 *ssm.StartAutomationExecutionInput) (*request.Request, *ssm.StartAutomationExecutionOutput) {
+	ret := _m.ctrl.Call(_m, "StartAutomationExecutionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.StartAutomationExecutionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) StartAutomationExecutionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StartAutomationExecutionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) StartAutomationExecution(_param0 *ssm.StartAutomationExecutionInput) (*ssm.StartAutomationExecutionOutput, error) {
+	ret := _m.ctrl.Call(_m, "StartAutomationExecution", _param0)
+	ret0, _ := ret[0].(*ssm.StartAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) StartAutomationExecution(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StartAutomationExecution", arg0)
+}
+
+func (_m *MockSSMAPI) StopAutomationExecutionRequest(_param0 *ssm.StopAutomationExecutionInput) (*request.Request, *ssm.StopAutomationExecutionOutput) {
+	ret := _m.ctrl.Call(_m, "StopAutomationExecutionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.StopAutomationExecutionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) StopAutomationExecutionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StopAutomationExecutionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) StopAutomationExecution(_param0 *ssm.StopAutomationExecutionInput) (*ssm.StopAutomationExecutionOutput, error) {
+	ret := _m.ctrl.Call(_m, "StopAutomationExecution", _param0)
+	ret0, _ := ret[0].(*ssm.StopAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) StopAutomationExecution(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StopAutomationExecution", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateAssociationRequest(_param0 *ssm.UpdateAssociationInput) (*request.Request, *ssm.UpdateAssociationOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateAssociationRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateAssociationOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateAssociationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAssociationRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateAssociation(_param0 *ssm.UpdateAssociationInput) (*ssm.UpdateAssociationOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateAssociation", _param0)
+	ret0, _ := ret[0].(*ssm.UpdateAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateAssociation(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAssociation", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateAssociationStatusRequest(_param0 *ssm.UpdateAssociationStatusInput) (*request.Request, *ssm.UpdateAssociationStatusOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateAssociationStatusRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateAssociationStatusOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateAssociationStatusRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAssociationStatusRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateAssociationStatus(_param0 *ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateAssociationStatus", _param0)
+	ret0, _ := ret[0].(*ssm.UpdateAssociationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateAssociationStatus(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAssociationStatus", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateDocumentRequest(_param0 *ssm.UpdateDocumentInput) (*request.Request, *ssm.UpdateDocumentOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateDocumentRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateDocumentOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateDocumentRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateDocumentRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateDocument(_param0 *ssm.UpdateDocumentInput) (*ssm.UpdateDocumentOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateDocument", _param0)
+	ret0, _ := ret[0].(*ssm.UpdateDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateDocument(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateDocument", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateDocumentDefaultVersionRequest(_param0 *ssm.UpdateDocumentDefaultVersionInput) (*request.Request, *ssm.UpdateDocumentDefaultVersionOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateDocumentDefaultVersionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateDocumentDefaultVersionOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateDocumentDefaultVersionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateDocumentDefaultVersionRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateDocumentDefaultVersion(_param0 *ssm.UpdateDocumentDefaultVersionInput) (*ssm.UpdateDocumentDefaultVersionOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateDocumentDefaultVersion", _param0)
+	ret0, _ := ret[0].(*ssm.UpdateDocumentDefaultVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateDocumentDefaultVersion(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateDocumentDefaultVersion", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateMaintenanceWindowRequest(_param0 *ssm.UpdateMaintenanceWindowInput) (*request.Request, *ssm.UpdateMaintenanceWindowOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateMaintenanceWindowRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateMaintenanceWindowRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateMaintenanceWindow(_param0 *ssm.UpdateMaintenanceWindowInput) (*ssm.UpdateMaintenanceWindowOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateMaintenanceWindow", _param0)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateMaintenanceWindow", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateManagedInstanceRoleRequest(_param0 *ssm.UpdateManagedInstanceRoleInput) (*request.Request, *ssm.UpdateManagedInstanceRoleOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateManagedInstanceRoleRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateManagedInstanceRoleOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateManagedInstanceRoleRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateManagedInstanceRoleRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdateManagedInstanceRole(_param0 *ssm.UpdateManagedInstanceRoleInput) (*ssm.UpdateManagedInstanceRoleOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateManagedInstanceRole", _param0)
+	ret0, _ := ret[0].(*ssm.UpdateManagedInstanceRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdateManagedInstanceRole(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateManagedInstanceRole", arg0)
+}
+
+func (_m *MockSSMAPI) UpdatePatchBaselineRequest(_param0 *ssm.UpdatePatchBaselineInput) (*request.Request, *ssm.UpdatePatchBaselineOutput) {
+	ret := _m.ctrl.Call(_m, "UpdatePatchBaselineRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdatePatchBaselineOutput)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdatePatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdatePatchBaselineRequest", arg0)
+}
+
+func (_m *MockSSMAPI) UpdatePatchBaseline(_param0 *ssm.UpdatePatchBaselineInput) (*ssm.UpdatePatchBaselineOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdatePatchBaseline", _param0)
+	ret0, _ := ret[0].(*ssm.UpdatePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSSMAPIRecorder) UpdatePatchBaseline(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdatePatchBaseline", arg0)
+}
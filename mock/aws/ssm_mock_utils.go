@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockSendCommandError will return error
+func MockSendCommandError(t *testing.T, mockMatcher *sdk.MockSSMAPI) {
+	logrus.Warningf("Mocking AWS iface: SendCommand")
+
+	err := errors.New("")
+
+	mockMatcher.EXPECT().SendCommand(gomock.Any()).AnyTimes().Return(nil, err)
+}
+
+// MockSendCommand will append the instances where the command was sent on the received slice
+func MockSendCommand(t *testing.T, mockMatcher *sdk.MockSSMAPI, commandID string, sent *[]string) {
+	logrus.Warningf("Mocking AWS iface: SendCommand")
+
+	var err error
+
+	resp := &ssm.SendCommandOutput{
+		Command: &ssm.Command{CommandId: aws.String(commandID)},
+	}
+	mockMatcher.EXPECT().SendCommand(gomock.Any()).Do(
+		func(input *ssm.SendCommandInput) {
+			for _, i := range input.InstanceIds {
+				*sent = append(*sent, aws.StringValue(i))
+			}
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockSendCommandInputs will append the received commands on the received slice, each command gets
+// its own ID with the received prefix
+func MockSendCommandInputs(t *testing.T, mockMatcher *sdk.MockSSMAPI, commandPrefix string, inputs *[]*ssm.SendCommandInput) {
+	logrus.Warningf("Mocking AWS iface: SendCommand")
+
+	var err error
+
+	// The returned command is set on each call
+	resp := &ssm.SendCommandOutput{}
+	mockMatcher.EXPECT().SendCommand(gomock.Any()).Do(
+		func(input *ssm.SendCommandInput) {
+			resp.Command = &ssm.Command{CommandId: aws.String(fmt.Sprintf("%s%d", commandPrefix, len(*inputs)))}
+			*inputs = append(*inputs, input)
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockListCommandInvocationsPages will return the received invocations
+func MockListCommandInvocationsPages(t *testing.T, mockMatcher *sdk.MockSSMAPI, invocations ...*ssm.CommandInvocation) {
+	logrus.Warningf("Mocking AWS iface: ListCommandInvocationsPages")
+
+	var err error
+
+	mockMatcher.EXPECT().ListCommandInvocationsPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ssm.ListCommandInvocationsInput, fn func(p *ssm.ListCommandInvocationsOutput, lastPage bool) (shouldContinue bool)) {
+			fn(&ssm.ListCommandInvocationsOutput{CommandInvocations: invocations}, true)
+		}).AnyTimes().Return(err)
+}
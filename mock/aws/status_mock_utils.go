@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDeregisterContainerInstance will append the deregistered container instance ARNs on the received slice
+func MockDeregisterContainerInstance(t *testing.T, mockMatcher *sdk.MockECSAPI, deregistered *[]string) {
+	logrus.Warningf("Mocking AWS iface: DeregisterContainerInstance")
+	var err error
+
+	mockMatcher.EXPECT().DeregisterContainerInstance(gomock.Any()).Do(
+		func(input *ecs.DeregisterContainerInstanceInput) {
+			if !aws.BoolValue(input.Force) {
+				t.Errorf("Deregister should be forced")
+			}
+			*deregistered = append(*deregistered, aws.StringValue(input.ContainerInstance))
+		}).AnyTimes().Return(&ecs.DeregisterContainerInstanceOutput{}, err)
+}
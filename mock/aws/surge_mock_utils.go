@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+// MockDescribeContainerInstancesSequence will return the received container instances lists in
+// order on each call, the last one is returned on the next calls
+func MockDescribeContainerInstancesSequence(t *testing.T, mockMatcher *sdk.MockECSAPI, sequence ...[]*ecs.ContainerInstance) {
+	logrus.Warningf("Mocking AWS iface: DescribeContainerInstances")
+
+	// The response is filled with the next list before returning it
+	var err error
+	call := 0
+	resp := &ecs.DescribeContainerInstancesOutput{}
+	mockMatcher.EXPECT().DescribeContainerInstances(gomock.Any()).Do(
+		func(input *ecs.DescribeContainerInstancesInput) {
+			i := call
+			if i >= len(sequence) {
+				i = len(sequence) - 1
+			}
+			resp.ContainerInstances = sequence[i]
+			call++
+		}).AnyTimes().Return(resp, err)
+}
+
+// MockTerminateInstanceInAutoScalingGroup will append the terminated instances on the received
+// slice, it fails the test if the desired capacity isn't decremented
+func MockTerminateInstanceInAutoScalingGroup(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, terminated *[]string) {
+	logrus.Warningf("Mocking AWS iface: TerminateInstanceInAutoScalingGroup")
+
+	var err error
+
+	mockMatcher.EXPECT().TerminateInstanceInAutoScalingGroup(gomock.Any()).Do(
+		func(input *autoscaling.TerminateInstanceInAutoScalingGroupInput) {
+			if !aws.BoolValue(input.ShouldDecrementDesiredCapacity) {
+				t.Errorf("Surged instances should decrement the desired capacity")
+			}
+			*terminated = append(*terminated, aws.StringValue(input.InstanceId))
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockAutoScalingGroupsDesired will return the requested groups from the received ones with the
+// desired capacity of the received map, setting the desired capacity updates the map
+func MockAutoScalingGroupsDesired(t *testing.T, mockMatcher *sdk.MockAutoScalingAPI, desired map[string]int64, groups ...*autoscaling.Group) {
+	logrus.Warningf("Mocking AWS iface: DescribeAutoScalingGroupsPages")
+	logrus.Warningf("Mocking AWS iface: SetDesiredCapacity")
+
+	var err error
+
+	mockMatcher.EXPECT().DescribeAutoScalingGroupsPages(gomock.Any(), gomock.Any()).Do(
+		func(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(p *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) {
+			resp := &autoscaling.DescribeAutoScalingGroupsOutput{}
+			for _, name := range input.AutoScalingGroupNames {
+				for _, g := range groups {
+					if aws.StringValue(g.AutoScalingGroupName) != aws.StringValue(name) {
+						continue
+					}
+					cp := *g
+					cp.DesiredCapacity = aws.Int64(desired[aws.StringValue(name)])
+					resp.AutoScalingGroups = append(resp.AutoScalingGroups, &cp)
+				}
+			}
+			fn(resp, true)
+		}).AnyTimes().Return(err)
+
+	mockMatcher.EXPECT().SetDesiredCapacity(gomock.Any()).Do(
+		func(input *autoscaling.SetDesiredCapacityInput) {
+			desired[aws.StringValue(input.AutoScalingGroupName)] = aws.Int64Value(input.DesiredCapacity)
+		}).AnyTimes().Return(nil, err)
+}
+
+// MockDescribeInstancesPagesLaunched will return the requested instances, the received ones
+// launched at the time of the call and the rest a day before
+func MockDescribeInstancesPagesLaunched(t *testing.T, mockMatcher *sdk.MockEC2API, launched ...string) {
+	logrus.Warningf("Mocking AWS iface: DescribeInstancesPages")
+
+	var err error
+	isNew := map[string]bool{}
+	for _, id := range launched {
+		isNew[id] = true
+	}
+
+	mockMatcher.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).Do(
+		func(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) {
+			r := &ec2.Reservation{}
+			for _, id := range input.InstanceIds {
+				lt := time.Now().Add(-24 * time.Hour)
+				if isNew[aws.StringValue(id)] {
+					lt = time.Now()
+				}
+				r.Instances = append(r.Instances, &ec2.Instance{InstanceId: id, LaunchTime: aws.Time(lt)})
+			}
+			fn(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{r}}, true)
+		}).AnyTimes().Return(err)
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// The dimension of the reconcile metrics
+const reconcileMetricDimension = "ClusterName"
+
+// ReconcileGroup is the autoscaling group side of the reconcile report
+type ReconcileGroup struct {
+	Name            string `json:"name"`
+	DesiredCapacity int64  `json:"desired_capacity"`
+	InService       int    `json:"in_service"`
+	Standby         int    `json:"standby"`
+}
+
+// ReconcileReport is the comparison of the autoscaling groups and the ECS cluster membership
+type ReconcileReport struct {
+	Time    time.Time         `json:"time"`
+	Cluster string            `json:"cluster"`
+	Groups  []*ReconcileGroup `json:"groups"`
+
+	// The totals of the groups
+	DesiredCapacity int64 `json:"desired_capacity"`
+	InService       int   `json:"in_service"`
+
+	// The container instances of the cluster
+	Registered int `json:"registered"`
+	Connected  int `json:"connected"`
+
+	// The mismatches
+	NotRegistered   []string `json:"not_registered"`
+	NotInGroup      []string `json:"not_in_group"`
+	Standby         []string `json:"standby"`
+	DesiredMismatch bool     `json:"desired_mismatch"`
+}
+
+// InSync returns true if the report doesn't have mismatches
+func (r *ReconcileReport) InSync() bool {
+	return len(r.NotRegistered) == 0 && len(r.NotInGroup) == 0 && len(r.Standby) == 0 && !r.DesiredMismatch
+}
+
+// Reconciler will compare the membership of the autoscaling groups that back the cluster with
+// the cluster container instances
+type Reconciler struct {
+	ecsCli  ecsiface.ECSAPI
+	asgCli  autoscalingiface.AutoScalingAPI
+	cwCli   cloudwatchiface.CloudWatchAPI
+	session *session.Session
+
+	// the name of the cluster
+	clusterName string
+
+	// The autoscaling groups of the cluster
+	asgs []string
+
+	// The CloudWatch namespace of the metrics
+	namespace string
+}
+
+// NewReconciler creates a Reconciler
+func NewReconciler(clusterName string, awsRegion string, asgs []string, namespace string) (*Reconciler, error) {
+	r := &Reconciler{
+		clusterName: clusterName,
+		asgs:        asgs,
+		namespace:   namespace,
+	}
+
+	// Create AWS session
+	s, err := newAWSSession(awsRegion)
+	if err != nil {
+		return nil, err
+	}
+	r.session = s
+
+	// Create the AWS clients
+	r.ecsCli = ecs.New(s)
+	r.asgCli = autoscaling.New(s)
+	r.cwCli = cloudwatch.New(s)
+
+	return r, nil
+}
+
+// Reconcile returns the report of the cluster membership
+func (r *Reconciler) Reconcile() (*ReconcileReport, error) {
+	report := &ReconcileReport{
+		Time:          time.Now().UTC(),
+		Cluster:       r.clusterName,
+		NotRegistered: []string{},
+		NotInGroup:    []string{},
+		Standby:       []string{},
+	}
+
+	// The autoscaling groups side
+	inService := map[string]bool{}
+	grouped := map[string]bool{}
+	params := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(r.asgs),
+	}
+	err := r.asgCli.DescribeAutoScalingGroupsPages(params,
+		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			for _, g := range page.AutoScalingGroups {
+				rg := &ReconcileGroup{
+					Name:            aws.StringValue(g.AutoScalingGroupName),
+					DesiredCapacity: aws.Int64Value(g.DesiredCapacity),
+				}
+				for _, i := range g.Instances {
+					id := aws.StringValue(i.InstanceId)
+					grouped[id] = true
+					switch aws.StringValue(i.LifecycleState) {
+					case autoscaling.LifecycleStateInService:
+						inService[id] = true
+						rg.InService++
+					case autoscaling.LifecycleStateStandby, autoscaling.LifecycleStateEnteringStandby:
+						report.Standby = append(report.Standby, id)
+						rg.Standby++
+					}
+				}
+				report.Groups = append(report.Groups, rg)
+				report.DesiredCapacity += rg.DesiredCapacity
+				report.InService += rg.InService
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cluster side
+	cis, err := describeContainerInstances(r.ecsCli, r.clusterName)
+	if err != nil {
+		return nil, err
+	}
+	registered := map[string]bool{}
+	for _, ci := range cis {
+		id := aws.StringValue(ci.Ec2InstanceId)
+		registered[id] = true
+		report.Registered++
+		if aws.BoolValue(ci.AgentConnected) {
+			report.Connected++
+		}
+		if !grouped[id] {
+			report.NotInGroup = append(report.NotInGroup, id)
+		}
+	}
+	for id := range inService {
+		if !registered[id] {
+			report.NotRegistered = append(report.NotRegistered, id)
+		}
+	}
+	report.DesiredMismatch = report.DesiredCapacity != int64(report.Registered)
+
+	sort.Strings(report.NotRegistered)
+	sort.Strings(report.NotInGroup)
+	sort.Strings(report.Standby)
+
+	return report, nil
+}
+
+// WriteReport will write the report as JSON
+func WriteReport(w io.Writer, report *ReconcileReport) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// PublishMetrics will publish the report as CloudWatch metrics of the cluster
+func (r *Reconciler) PublishMetrics(report *ReconcileReport) error {
+	values := []struct {
+		name  string
+		value float64
+	}{
+		{"DesiredCapacity", float64(report.DesiredCapacity)},
+		{"InServiceInstances", float64(report.InService)},
+		{"RegisteredInstances", float64(report.Registered)},
+		{"ConnectedInstances", float64(report.Connected)},
+		{"NotRegisteredInstances", float64(len(report.NotRegistered))},
+		{"NotInGroupInstances", float64(len(report.NotInGroup))},
+		{"StandbyInstances", float64(len(report.Standby))},
+		{"DesiredCapacityMismatch", float64(report.DesiredCapacity - int64(report.Registered))},
+	}
+
+	data := make([]*cloudwatch.MetricDatum, len(values))
+	for i, v := range values {
+		data[i] = &cloudwatch.MetricDatum{
+			MetricName: aws.String(v.name),
+			Dimensions: []*cloudwatch.Dimension{
+				{Name: aws.String(reconcileMetricDimension), Value: aws.String(r.clusterName)},
+			},
+			Timestamp: aws.Time(report.Time),
+			Unit:      aws.String(cloudwatch.StandardUnitCount),
+			Value:     aws.Float64(v.value),
+		}
+	}
+
+	params := &cloudwatch.PutMetricDataInput{
+		Namespace:  aws.String(r.namespace),
+		MetricData: data,
+	}
+	_, err := r.cwCli.PutMetricData(params)
+	return err
+}
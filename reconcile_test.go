@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+
+	awsMock "github.com/slok/ecs-watcher/mock/aws"
+	"github.com/slok/ecs-watcher/mock/aws/sdk"
+)
+
+func TestReconcilerReconcile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECSCli := sdk.NewMockECSAPI(ctrl)
+	mockASGCli := sdk.NewMockAutoScalingAPI(ctrl)
+	mockCWCli := sdk.NewMockCloudWatchAPI(ctrl)
+
+	asgInstance := func(id, state string) *autoscaling.Instance {
+		return &autoscaling.Instance{InstanceId: aws.String(id), LifecycleState: aws.String(state)}
+	}
+	awsMock.MockDescribeAutoScalingGroupsPagesGroups(t, mockASGCli,
+		&autoscaling.Group{
+			AutoScalingGroupName: aws.String("asg-0"),
+			DesiredCapacity:      aws.Int64(3),
+			Instances: []*autoscaling.Instance{
+				asgInstance("i-0", autoscaling.LifecycleStateInService),
+				asgInstance("i-1", autoscaling.LifecycleStateInService),
+				asgInstance("i-2", autoscaling.LifecycleStateStandby),
+			},
+		},
+		&autoscaling.Group{
+			AutoScalingGroupName: aws.String("asg-1"),
+			DesiredCapacity:      aws.Int64(1),
+			Instances: []*autoscaling.Instance{
+				asgInstance("i-3", autoscaling.LifecycleStateInService),
+			},
+		},
+	)
+	// i-1 isn't registered, i-9 isn't on any group
+	awsMock.MockListContainerInstancesPagesQ(t, mockECSCli, 4)
+	awsMock.MockDescribeContainerInstances(t, mockECSCli,
+		&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-0"), AgentConnected: aws.Bool(true)},
+		&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-2"), AgentConnected: aws.Bool(true)},
+		&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-3"), AgentConnected: aws.Bool(false)},
+		&ecs.ContainerInstance{Ec2InstanceId: aws.String("i-9"), AgentConnected: aws.Bool(true)},
+	)
+
+	r := &Reconciler{
+		ecsCli:      mockECSCli,
+		asgCli:      mockASGCli,
+		cwCli:       mockCWCli,
+		clusterName: "test",
+		asgs:        []string{"asg-0", "asg-1"},
+		namespace:   "Test",
+	}
+
+	report, err := r.Reconcile()
+	if err != nil {
+		t.Fatalf("Reconcile shouldn't give an error: %s", err)
+	}
+
+	if report.DesiredCapacity != 4 || report.InService != 3 || report.Registered != 4 || report.Connected != 3 {
+		t.Errorf("Wrong report totals; got: %+v", report)
+	}
+	if !reflect.DeepEqual(report.NotRegistered, []string{"i-1"}) {
+		t.Errorf("Wrong not registered instances; got: %v", report.NotRegistered)
+	}
+	if !reflect.DeepEqual(report.NotInGroup, []string{"i-9"}) {
+		t.Errorf("Wrong not in group instances; got: %v", report.NotInGroup)
+	}
+	if !reflect.DeepEqual(report.Standby, []string{"i-2"}) {
+		t.Errorf("Wrong standby instances; got: %v", report.Standby)
+	}
+	if report.DesiredMismatch {
+		t.Errorf("Desired capacity should match the registered instances")
+	}
+	if report.InSync() {
+		t.Errorf("Report shouldn't be in sync")
+	}
+
+	// Report output
+	b := &bytes.Buffer{}
+	if err := WriteReport(b, report); err != nil {
+		t.Fatalf("WriteReport shouldn't give an error: %s", err)
+	}
+	got := &ReconcileReport{}
+	if err := json.Unmarshal(b.Bytes(), got); err != nil {
+		t.Fatalf("Report should be JSON: %s", err)
+	}
+	if got.Cluster != "test" || len(got.Groups) != 2 {
+		t.Errorf("Wrong written report; got: %+v", got)
+	}
+
+	// Metrics
+	metrics := map[string]float64{}
+	awsMock.MockPutMetricData(t, mockCWCli, metrics)
+	if err := r.PublishMetrics(report); err != nil {
+		t.Fatalf("PublishMetrics shouldn't give an error: %s", err)
+	}
+	want := map[string]float64{
+		"DesiredCapacity":         4,
+		"InServiceInstances":      3,
+		"RegisteredInstances":     4,
+		"ConnectedInstances":      3,
+		"NotRegisteredInstances":  1,
+		"NotInGroupInstances":     1,
+		"StandbyInstances":        1,
+		"DesiredCapacityMismatch": 0,
+	}
+	if !reflect.DeepEqual(metrics, want) {
+		t.Errorf("Wrong metrics; got: %v, want: %v", metrics, want)
+	}
+}
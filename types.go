@@ -1,5 +1,11 @@
 package main
 
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
 // Checker is an interface that represents a cluster checker
 type Checker interface {
 	// Will check if the cluster is ok
@@ -9,11 +15,46 @@ type Checker interface {
 	Mark() error
 }
 
+// InstanceChecker is an interface that represents a checker of the cluster instances health,
+// it decides on a snapshot of the cluster so multiple checkers can share the same data
+type InstanceChecker interface {
+	// Unhealthy returns the verdicts of the unhealthy instances
+	Unhealthy(snapshot *ClusterSnapshot) (Verdicts, error)
+}
+
+// Verdict is the reason of a checker to declare an instance unhealthy
+type Verdict struct {
+	// The checker that declared the instance unhealthy
+	Checker string `json:"checker"`
+	// Why the instance is unhealthy
+	Reason string `json:"reason"`
+	// The EC2 instance isn't running, the container instance only needs to be deregistered
+	Deregister bool `json:"deregister,omitempty"`
+	// The time the instance needs to be unhealthy before marking it, zero uses unhealthy.after
+	After time.Duration `json:"-"`
+}
+
+// Verdicts are the unhealthy verdicts of the instances by EC2 instance ID
+type Verdicts map[string][]*Verdict
+
+// add adds a verdict to an instance
+func (v Verdicts) add(id string, verdicts ...*Verdict) {
+	v[id] = append(v[id], verdicts...)
+}
+
 // Cleaner interface represents the one that will take the action of cleaning marked targets
 type Cleaner interface {
 	Clean() error
 }
 
+// Remediator is an interface that represents a step of an escalation chain that tries to fix
+// the marked instances
+type Remediator interface {
+	// Remediate will apply the remediation on the instances, it returns the instances that
+	// the step can't remediate so they go to the next step straight away
+	Remediate(instances []*ec2.Instance) ([]*ec2.Instance, error)
+}
+
 //MarkTag represents the marking tag
 type MarkTag struct {
 	key   string
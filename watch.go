@@ -3,8 +3,6 @@ package main
 import (
 	"fmt"
 	"time"
-
-	"github.com/Sirupsen/logrus"
 )
 
 // Watcher  represents a watcher that will run every X time checking the status of the
@@ -21,17 +19,23 @@ type Watcher struct {
 }
 
 // NewWatcher creates anew watcher
-func NewWatcher(cfg Config) (*Watcher, error) {
+func NewWatcher(cfg Config, auditor Auditor) (*Watcher, error) {
 	w := &Watcher{
 		clusterName: cfg.clusterName,
 		interval:    cfg.checkInterval,
 	}
 
-	// TODO: Checker selection
-	c, err := NewAgentChecker(w.clusterName, cfg.awsRegion, cfg.unhealthyTag, cfg.markAfter)
+	c, err := NewAgentChecker(w.clusterName, cfg.awsRegion, cfg.unhealthyTag, cfg.markAfter, auditor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the checkers that decide the unhealthy instances
+	ic, err := newInstanceChecker(cfg, c.session)
 	if err != nil {
 		return nil, err
 	}
+	c.checker = ic
 
 	w.checker = c
 	return w, nil
@@ -43,16 +47,17 @@ func (w *Watcher) Run() error {
 		return fmt.Errorf("No checker active on the watcher")
 	}
 
-	logrus.Infof("Starting to watch '%s' cluster every %s", w.clusterName, w.interval)
+	log := componentLog(w.clusterName, componentWatcher)
+	log.WithField("interval", w.interval.String()).Info("Starting to watch cluster")
 	t := time.NewTicker(w.interval)
 
 	for range t.C {
 		if err := w.checker.Check(); err != nil {
-			logrus.Errorf("Error checking instances: %s", err)
+			log.WithError(err).Error("Error checking instances")
 			continue
 		}
 		if err := w.checker.Mark(); err != nil {
-			logrus.Errorf("Error marking instances: %s", err)
+			log.WithError(err).Error("Error marking instances")
 			continue
 		}
 	}